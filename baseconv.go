@@ -0,0 +1,249 @@
+package xtd
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Common alphabets for use with the Encode* and Decode* functions.
+const (
+	// AlphabetBase36 contains the digits and lowercase ASCII letters.
+	AlphabetBase36 = "0123456789abcdefghijklmnopqrstuvwxyz"
+	// AlphabetBase58 is the Bitcoin base58 alphabet, which omits the easily
+	// confused 0, O, I and l.
+	AlphabetBase58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	// AlphabetBase62 contains the digits and ASCII letters, uppercase first.
+	AlphabetBase62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// InvalidCharacterError is returned when decoding a string containing
+// a rune which is not part of the alphabet, recording the rune and its byte offset.
+type InvalidCharacterError struct {
+	Char rune
+	Pos  int
+}
+
+func (e *InvalidCharacterError) Error() string {
+	return fmt.Sprintf("invalid character %q at position %d", e.Char, e.Pos)
+}
+
+// EncodeInt encodes the passed integer using the passed alphabet, the length
+// of which is the base (AlphabetBase36, AlphabetBase58 and AlphabetBase62 are provided).
+// Negative integers are prefixed with '-'.
+// ErrInvalidAlphabet is returned if the alphabet has fewer than two runes,
+// contains a rune more than once, or contains '-'.
+func EncodeInt[T constraints.Integer](n T, alphabet string) (string, error) {
+	v := new(big.Int)
+	if n < 0 {
+		v.SetInt64(int64(n))
+	} else {
+		v.SetUint64(uint64(n))
+	}
+
+	return EncodeBig(v, alphabet)
+}
+
+// DecodeInt decodes the passed string, as produced by EncodeInt with the same alphabet,
+// returning any integer type based on the specified type constraint.
+// An *InvalidCharacterError is returned if the string contains a rune outside the alphabet.
+// Like IntFromString, a *strconv.NumError wrapping strconv.ErrSyntax is returned for an
+// empty string, or wrapping strconv.ErrRange if the value does not fit in T.
+func DecodeInt[T constraints.Integer](s, alphabet string) (res T, err error) {
+	v, err := DecodeBig(s, alphabet)
+	if err != nil {
+		if numErr, ok := err.(*strconv.NumError); ok {
+			numErr.Func = "DecodeInt"
+		}
+
+		return 0, err
+	}
+
+	maxVal := new(big.Int).SetUint64(maxIntegerValue[T]())
+
+	minVal := new(big.Int)
+	if ^T(0) < 0 {
+		minVal.Neg(maxVal).Sub(minVal, big.NewInt(1))
+	}
+
+	if v.Cmp(maxVal) > 0 || v.Cmp(minVal) < 0 {
+		return 0, &strconv.NumError{Func: "DecodeInt", Num: s, Err: strconv.ErrRange}
+	}
+
+	if v.Sign() < 0 {
+		return T(v.Int64()), nil
+	}
+
+	return T(v.Uint64()), nil
+}
+
+// EncodeBig encodes the passed arbitrarily large integer using the passed alphabet.
+// See EncodeInt for details.
+func EncodeBig(n *big.Int, alphabet string) (string, error) {
+	runes, err := baseAlphabet(alphabet)
+	if err != nil {
+		return "", err
+	}
+
+	if n.Sign() == 0 {
+		return string(runes[0]), nil
+	}
+
+	var (
+		base   = big.NewInt(int64(len(runes)))
+		v      = new(big.Int).Abs(n)
+		digit  = new(big.Int)
+		digits []rune
+	)
+
+	for v.Sign() > 0 {
+		v.DivMod(v, base, digit)
+		digits = append(digits, runes[digit.Int64()])
+	}
+
+	if n.Sign() < 0 {
+		digits = append(digits, '-')
+	}
+
+	return string(reverseRunes(digits)), nil
+}
+
+// DecodeBig decodes the passed string, as produced by EncodeBig with the same alphabet,
+// into an arbitrarily large integer. See DecodeInt for details.
+func DecodeBig(s, alphabet string) (*big.Int, error) {
+	runes, err := baseAlphabet(alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	digits := strings.TrimPrefix(s, "-")
+	if digits == "" {
+		return nil, &strconv.NumError{Func: "DecodeBig", Num: s, Err: strconv.ErrSyntax}
+	}
+
+	v, err := decodeBaseDigits(digits, runes, len(s)-len(digits))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(digits) != len(s) {
+		v.Neg(v)
+	}
+
+	return v, nil
+}
+
+// EncodeBytes encodes the passed bytes, treated as a big-endian unsigned integer,
+// using the passed alphabet. As with Bitcoin's base58, each leading zero byte is
+// encoded as a leading alphabet[0], so that the exact input is recovered by DecodeBytes.
+// ErrInvalidAlphabet is returned under the same conditions as EncodeInt.
+func EncodeBytes(data []byte, alphabet string) (string, error) {
+	runes, err := baseAlphabet(alphabet)
+	if err != nil {
+		return "", err
+	}
+
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	prefix := strings.Repeat(string(runes[0]), zeros)
+
+	if zeros == len(data) {
+		return prefix, nil
+	}
+
+	encoded, err := EncodeBig(new(big.Int).SetBytes(data[zeros:]), alphabet)
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + encoded, nil
+}
+
+// DecodeBytes decodes the passed string, as produced by EncodeBytes with the same alphabet.
+// An *InvalidCharacterError is returned if the string contains a rune outside the alphabet.
+func DecodeBytes(s, alphabet string) ([]byte, error) {
+	runes, err := baseAlphabet(alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	zeroRune := string(runes[0])
+
+	rest := s
+	zeros := 0
+
+	for strings.HasPrefix(rest, zeroRune) {
+		rest = rest[len(zeroRune):]
+		zeros++
+	}
+
+	res := make([]byte, zeros)
+
+	if rest == "" {
+		return res, nil
+	}
+
+	v, err := decodeBaseDigits(rest, runes, len(s)-len(rest))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(res, v.Bytes()...), nil
+}
+
+func decodeBaseDigits(digits string, runes []rune, offset int) (*big.Int, error) {
+	index := make(map[rune]int64, len(runes))
+	for i, r := range runes {
+		index[r] = int64(i)
+	}
+
+	var (
+		base = big.NewInt(int64(len(runes)))
+		v    = new(big.Int)
+	)
+
+	for i, r := range digits {
+		d, ok := index[r]
+		if !ok {
+			return nil, &InvalidCharacterError{r, offset + i}
+		}
+
+		v.Mul(v, base)
+		v.Add(v, big.NewInt(d))
+	}
+
+	return v, nil
+}
+
+func baseAlphabet(alphabet string) ([]rune, error) {
+	runes := []rune(alphabet)
+
+	if err := validateAlphabet(runes); err != nil {
+		return nil, err
+	}
+
+	if len(runes) < 2 {
+		return nil, fmt.Errorf("%w: base must be at least 2", ErrInvalidAlphabet)
+	}
+
+	if strings.ContainsRune(alphabet, '-') || !utf8.ValidString(alphabet) {
+		return nil, fmt.Errorf("%w: must be valid UTF-8 and not contain '-'", ErrInvalidAlphabet)
+	}
+
+	return runes, nil
+}
+
+func reverseRunes(runes []rune) []rune {
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+
+	return runes
+}
@@ -0,0 +1,150 @@
+package xtd_test
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestEncodeInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		arg      int64
+		alphabet string
+		want     string
+	}{
+		{"zero base36", 0, xtd.AlphabetBase36, "0"},
+		{"base36", 1234567890, xtd.AlphabetBase36, "kf12oi"},
+		{"base58", 1234567890, xtd.AlphabetBase58, "2t6V2H"},
+		{"base62", 1234567890, xtd.AlphabetBase62, "1LY7VK"},
+		{"binary", 10, "01", "1010"},
+		{"negative", -35, xtd.AlphabetBase36, "-z"},
+		{"min int64", math.MinInt64, xtd.AlphabetBase36, "-1y2p0ij32e8e8"},
+		{"unicode alphabet", 5, "○●", "●○●"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.EncodeInt(tt.arg, tt.alphabet)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			decoded, err := xtd.DecodeInt[int64](got, tt.alphabet)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.arg, decoded)
+		})
+	}
+
+	t.Run("max uint64", func(t *testing.T) {
+		got, err := xtd.EncodeInt(uint64(math.MaxUint64), xtd.AlphabetBase62)
+		assert.NoError(t, err)
+		assert.Equal(t, "LygHa16AHYF", got)
+
+		decoded, err := xtd.DecodeInt[uint64](got, xtd.AlphabetBase62)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(math.MaxUint64), decoded)
+	})
+
+	t.Run("invalid alphabet", func(t *testing.T) {
+		for _, alphabet := range []string{"", "a", "aba", "ab-"} {
+			_, err := xtd.EncodeInt(1, alphabet)
+			assert.ErrorIs(t, err, xtd.ErrInvalidAlphabet, "alphabet %q", alphabet)
+		}
+	})
+}
+
+func TestDecodeInt_errors(t *testing.T) {
+	t.Run("invalid character", func(t *testing.T) {
+		_, err := xtd.DecodeInt[int]("2t0V2H", xtd.AlphabetBase58)
+
+		var charErr *xtd.InvalidCharacterError
+		if assert.ErrorAs(t, err, &charErr) {
+			assert.Equal(t, '0', charErr.Char)
+			assert.Equal(t, 2, charErr.Pos)
+		}
+	})
+
+	t.Run("invalid character after sign", func(t *testing.T) {
+		_, err := xtd.DecodeInt[int]("-a!", xtd.AlphabetBase36)
+
+		var charErr *xtd.InvalidCharacterError
+		if assert.ErrorAs(t, err, &charErr) {
+			assert.Equal(t, 2, charErr.Pos)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := xtd.DecodeInt[int]("-", xtd.AlphabetBase36)
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		_, err := xtd.DecodeInt[uint8]("74", xtd.AlphabetBase36)
+		assert.ErrorIs(t, err, strconv.ErrRange)
+
+		got, err := xtd.DecodeInt[uint8]("73", xtd.AlphabetBase36)
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(255), got)
+
+		_, err = xtd.DecodeInt[uint8]("-1", xtd.AlphabetBase36)
+		assert.ErrorIs(t, err, strconv.ErrRange)
+
+		got8, err := xtd.DecodeInt[int8]("-3k", xtd.AlphabetBase36)
+		assert.NoError(t, err)
+		assert.Equal(t, int8(-128), got8)
+
+		_, err = xtd.DecodeInt[int8]("-3l", xtd.AlphabetBase36)
+		assert.ErrorIs(t, err, strconv.ErrRange)
+	})
+}
+
+func TestEncodeBig(t *testing.T) {
+	n, _ := new(big.Int).SetString("123456789012345678901234567890123456789", 10)
+
+	got, err := xtd.EncodeBig(n, xtd.AlphabetBase62)
+	assert.NoError(t, err)
+
+	decoded, err := xtd.DecodeBig(got, xtd.AlphabetBase62)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n.Cmp(decoded))
+}
+
+func TestEncodeBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  []byte
+		want string
+	}{
+		{"empty", []byte{}, ""},
+		{"hello world", []byte("hello world"), "StV1DL6CwTryKyV"},
+		{"leading zeros", []byte{0, 0, 1}, "112"},
+		{"all zeros", []byte{0, 0}, "11"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.EncodeBytes(tt.arg, xtd.AlphabetBase58)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			decoded, err := xtd.DecodeBytes(got, xtd.AlphabetBase58)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.arg, decoded)
+		})
+	}
+
+	t.Run("invalid character", func(t *testing.T) {
+		_, err := xtd.DecodeBytes("11StV1Dl", xtd.AlphabetBase58)
+
+		var charErr *xtd.InvalidCharacterError
+		if assert.ErrorAs(t, err, &charErr) {
+			assert.Equal(t, 'l', charErr.Char)
+			assert.Equal(t, 7, charErr.Pos)
+		}
+	})
+}
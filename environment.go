@@ -3,6 +3,7 @@ package xtd
 import (
 	"os"
 	"strconv"
+	"strings"
 
 	"golang.org/x/exp/constraints"
 )
@@ -11,6 +12,28 @@ func fromEnv(key string) (string, bool) {
 	return os.LookupEnv(key)
 }
 
+// EnvKeys returns the keys of all variables currently set in the environment.
+func EnvKeys() []string {
+	environ := os.Environ()
+	keys := make([]string, 0, len(environ))
+
+	for _, kv := range environ {
+		if key, _, ok := strings.Cut(kv, "="); ok && key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// ClosestEnvKeys returns up to n keys set in the environment which
+// most closely resemble the passed key, as ranked by ClosestMatches.
+// It is intended for "did you mean ...?" hints when a lookup
+// such as StringFromEnv falls back because key is not set.
+func ClosestEnvKeys(key string, n int) []string {
+	return ClosestMatches(key, EnvKeys(), n)
+}
+
 // StringFromEnv returns a string value from the environment set
 // at the given key, or the passed fallback if the key is not set.
 func StringFromEnv(key string, fallback string) (val string, ok bool) {
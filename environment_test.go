@@ -240,3 +240,10 @@ func TestUintFromEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestClosestEnvKeys(t *testing.T) {
+	setEnv(t, "XTD_TEST_LOG_LEVEL", "debug")
+
+	got := xtd.ClosestEnvKeys("XTD_TEST_LOG_LEVL", 1)
+	assert.Equal(t, []string{"XTD_TEST_LOG_LEVEL"}, got)
+}
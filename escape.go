@@ -0,0 +1,312 @@
+package xtd
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// ErrInvalidEscape is returned (wrapped along with the byte offset at which it
+// was found) when unescaping a string containing a malformed escape sequence.
+var ErrInvalidEscape = errors.New("invalid escape sequence")
+
+// EscapeLike escapes the passed string for literal use within a SQL LIKE or ILIKE
+// pattern, by prefixing each '%', '_' and escape rune with escape.
+// The query must declare the same escape rune, such as `LIKE ? ESCAPE '\'`.
+func EscapeLike(s string, escape rune) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	for _, r := range s {
+		if r == '%' || r == '_' || r == escape {
+			sb.WriteRune(escape)
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// UnescapeLike is the inverse of EscapeLike, removing the escape rune
+// from before each rune it escapes.
+// A trailing, unpaired escape rune is returned wrapped in ErrInvalidEscape.
+func UnescapeLike(s string, escape rune) (string, error) {
+	var (
+		sb      strings.Builder
+		escaped bool
+		last    int
+	)
+
+	sb.Grow(len(s))
+
+	for i, r := range s {
+		if r == escape && !escaped {
+			escaped, last = true, i
+			continue
+		}
+
+		escaped = false
+		sb.WriteRune(r)
+	}
+
+	if escaped {
+		return "", fmt.Errorf("%w: trailing escape at position %d", ErrInvalidEscape, last)
+	}
+
+	return sb.String(), nil
+}
+
+// QuoteRegexp returns the passed string with all regular expression
+// metacharacters escaped, such that it matches itself literally.
+// It is equivalent to regexp.QuoteMeta.
+func QuoteRegexp(s string) string {
+	return regexp.QuoteMeta(s)
+}
+
+// UnquoteRegexp is the inverse of QuoteRegexp, removing the backslash
+// from before each escaped regular expression metacharacter.
+// Backslashes before any other character are left as-is.
+func UnquoteRegexp(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(`\.+*?()|[]{}^$`, s[i+1]) != -1 {
+			i++
+		}
+
+		sb.WriteByte(s[i])
+	}
+
+	return sb.String()
+}
+
+// EscapeJSONString returns the passed string escaped for use inside a JSON string literal,
+// without the surrounding quotes. Quotes, backslashes and control characters are escaped,
+// as are U+2028 and U+2029 so the output is also safe within JavaScript source.
+// Invalid UTF-8 is replaced with U+FFFD, as encoding/json does.
+func EscapeJSONString(s string) string {
+	const hex = "0123456789abcdef"
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				sb.WriteByte('\\')
+				sb.WriteByte(c)
+			case c == '\n':
+				sb.WriteString(`\n`)
+			case c == '\r':
+				sb.WriteString(`\r`)
+			case c == '\t':
+				sb.WriteString(`\t`)
+			case c == '\b':
+				sb.WriteString(`\b`)
+			case c == '\f':
+				sb.WriteString(`\f`)
+			case c < 0x20:
+				sb.WriteString(`\u00`)
+				sb.WriteByte(hex[c>>4])
+				sb.WriteByte(hex[c&0xf])
+			default:
+				sb.WriteByte(c)
+			}
+
+			i++
+
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+
+		switch {
+		case r == utf8.RuneError && size == 1:
+			sb.WriteString(`\ufffd`)
+		case r == '\u2028' || r == '\u2029':
+			sb.WriteString(`\u202`)
+			sb.WriteByte(hex[r&0xf])
+		default:
+			sb.WriteString(s[i : i+size])
+		}
+
+		i += size
+	}
+
+	return sb.String()
+}
+
+// UnescapeJSONString is the inverse of EscapeJSONString, decoding the escape sequences
+// of a JSON string literal's contents (without the surrounding quotes),
+// including UTF-16 surrogate pairs such as `\ud83d\ude80`.
+// Malformed escapes, and unescaped quotes or control characters,
+// are returned wrapped in ErrInvalidEscape.
+func UnescapeJSONString(s string) (string, error) {
+	if strings.IndexByte(s, '\\') == -1 && strings.IndexByte(s, '"') == -1 && !hasControlChar(s) {
+		return s, nil
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '"' || c < 0x20:
+			return "", fmt.Errorf("%w: unescaped %q at position %d", ErrInvalidEscape, c, i)
+		case c != '\\':
+			sb.WriteByte(c)
+			continue
+		case i+1 == len(s):
+			return "", fmt.Errorf("%w: trailing backslash at position %d", ErrInvalidEscape, i)
+		}
+
+		start := i
+		i++
+
+		switch s[i] {
+		case '"', '\\', '/':
+			sb.WriteByte(s[i])
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'u':
+			r, ok := parseJSONHex(s, i+1)
+			if !ok {
+				return "", fmt.Errorf("%w: malformed \\u escape at position %d", ErrInvalidEscape, start)
+			}
+
+			i += 4
+
+			if utf16.IsSurrogate(r) {
+				if low, ok := parseJSONHex(s, i+3); ok && strings.HasPrefix(s[i+1:], `\u`) {
+					if dec := utf16.DecodeRune(r, low); dec != utf8.RuneError {
+						r = dec
+						i += 6
+					}
+				}
+			}
+
+			sb.WriteRune(r)
+		default:
+			return "", fmt.Errorf("%w: unknown escape %q at position %d", ErrInvalidEscape, s[start:i+1], start)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func parseJSONHex(s string, i int) (rune, bool) {
+	if i+4 > len(s) {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(s[i:i+4], 16, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return rune(n), true
+}
+
+func hasControlChar(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QuoteCSVField returns the passed field quoted for use in a CSV record using
+// the passed field separator (usually ','), following the same rules as encoding/csv:
+// fields containing the separator, quotes, line breaks or leading whitespace
+// are wrapped in double quotes, with any quotes within doubled.
+// Other fields are returned as-is.
+func QuoteCSVField(field string, comma rune) string {
+	if !csvFieldNeedsQuotes(field, comma) {
+		return field
+	}
+
+	return `"` + strings.ReplaceAll(field, `"`, `""`) + `"`
+}
+
+// UnquoteCSVField is the inverse of QuoteCSVField. Unquoted fields are returned as-is;
+// quoted fields have their surrounding quotes removed and doubled quotes collapsed.
+// A quoted field which is not properly terminated, or which contains a lone quote,
+// is returned wrapped in ErrInvalidEscape.
+func UnquoteCSVField(field string) (string, error) {
+	if !strings.HasPrefix(field, `"`) {
+		return field, nil
+	}
+
+	if len(field) < 2 || !strings.HasSuffix(field, `"`) {
+		return "", fmt.Errorf("%w: unterminated quoted field", ErrInvalidEscape)
+	}
+
+	inner := field[1 : len(field)-1]
+
+	for i := 0; i < len(inner); i++ {
+		if inner[i] != '"' {
+			continue
+		}
+
+		if i+1 == len(inner) || inner[i+1] != '"' {
+			return "", fmt.Errorf("%w: lone quote at position %d", ErrInvalidEscape, i+1)
+		}
+
+		i++
+	}
+
+	return strings.ReplaceAll(inner, `""`, `"`), nil
+}
+
+func csvFieldNeedsQuotes(field string, comma rune) bool {
+	if field == "" {
+		return false
+	}
+
+	if field == `\.` || strings.ContainsRune(field, comma) || strings.ContainsAny(field, "\"\r\n") {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(field)
+
+	return r == ' ' || r == '\t'
+}
+
+// EscapeHTMLAttr escapes the passed string for use within a quoted HTML attribute value,
+// escaping ampersands, angle brackets, and double and single quotes.
+// It is equivalent to html.EscapeString.
+func EscapeHTMLAttr(s string) string {
+	return html.EscapeString(s)
+}
+
+// UnescapeHTMLAttr is the inverse of EscapeHTMLAttr, additionally decoding any other
+// HTML entities. It is equivalent to html.UnescapeString.
+func UnescapeHTMLAttr(s string) string {
+	return html.UnescapeString(s)
+}
@@ -0,0 +1,179 @@
+package xtd_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		name   string
+		arg    string
+		escape rune
+		want   string
+	}{
+		{"plain", "hello", '\\', "hello"},
+		{"wildcards", "50%_off", '\\', `50\%\_off`},
+		{"escape rune", `C:\dir`, '\\', `C:\\dir`},
+		{"custom escape", "a!b%c", '!', "a!!b!%c"},
+		{"empty", "", '\\', ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := xtd.EscapeLike(tt.arg, tt.escape)
+			assert.Equal(t, tt.want, got)
+
+			unescaped, err := xtd.UnescapeLike(got, tt.escape)
+			require.NoError(t, err)
+			assert.Equal(t, tt.arg, unescaped)
+		})
+	}
+
+	t.Run("trailing escape", func(t *testing.T) {
+		_, err := xtd.UnescapeLike(`abc\`, '\\')
+		assert.ErrorIs(t, err, xtd.ErrInvalidEscape)
+	})
+}
+
+func TestQuoteRegexp(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+	}{
+		{"plain", "hello"},
+		{"metacharacters", `a.b*c+d?(e)[f]{g}|h^i$j\k`},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quoted := xtd.QuoteRegexp(tt.arg)
+			assert.True(t, regexp.MustCompile("^"+quoted+"$").MatchString(tt.arg))
+			assert.Equal(t, tt.arg, xtd.UnquoteRegexp(quoted))
+		})
+	}
+
+	t.Run("other escapes kept", func(t *testing.T) {
+		assert.Equal(t, `a\db.`, xtd.UnquoteRegexp(`a\db\.`))
+	})
+}
+
+func TestEscapeJSONString(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{"plain", "hello", "hello"},
+		{"quotes and backslashes", `say "hi" \o/`, `say \"hi\" \\o/`},
+		{"control characters", "a\nb\tc\x01", `a\nb\tc\u0001`},
+		{"unicode", "héllo 🚀", "héllo 🚀"},
+		{"line separators", "a\u2028b\u2029", `a\u2028b\u2029`},
+		{"invalid utf-8", "a\xffb", `a\ufffdb`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := xtd.EscapeJSONString(tt.arg)
+			assert.Equal(t, tt.want, got)
+
+			var decoded string
+			require.NoError(t, json.Unmarshal([]byte(`"`+got+`"`), &decoded))
+			assert.Equal(t, strings.ToValidUTF8(tt.arg, "\ufffd"), decoded)
+		})
+	}
+}
+
+func TestUnescapeJSONString(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    string
+		wantErr bool
+	}{
+		{"plain", "hello", "hello", false},
+		{"simple escapes", `a\"b\\c\/d\n`, "a\"b\\c/d\n", false},
+		{"unicode escape", `caf\u00e9`, "café", false},
+		{"surrogate pair", `\ud83d\ude80!`, "🚀!", false},
+		{"lone surrogate", `\ud83d`, "\ufffd", false},
+		{"unknown escape", `\x41`, "", true},
+		{"short unicode escape", `\u12`, "", true},
+		{"trailing backslash", `abc\`, "", true},
+		{"unescaped quote", `a"b`, "", true},
+		{"unescaped newline", "a\nb", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.UnescapeJSONString(tt.arg)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, xtd.ErrInvalidEscape)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQuoteCSVField(t *testing.T) {
+	tests := []struct {
+		name  string
+		arg   string
+		comma rune
+		want  string
+	}{
+		{"plain", "hello", ',', "hello"},
+		{"separator", "a,b", ',', `"a,b"`},
+		{"other separator", "a,b", ';', "a,b"},
+		{"quotes", `say "hi"`, ',', `"say ""hi"""`},
+		{"newline", "a\nb", ',', "\"a\nb\""},
+		{"leading space", " a", ',', `" a"`},
+		{"empty", "", ',', ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := xtd.QuoteCSVField(tt.arg, tt.comma)
+			assert.Equal(t, tt.want, got)
+
+			unquoted, err := xtd.UnquoteCSVField(got)
+			require.NoError(t, err)
+			assert.Equal(t, tt.arg, unquoted)
+
+			r := csv.NewReader(strings.NewReader(got + "\n"))
+			r.Comma = tt.comma
+
+			if tt.arg != "" {
+				record, err := r.Read()
+				require.NoError(t, err)
+				assert.Equal(t, []string{tt.arg}, record)
+			}
+		})
+	}
+
+	for _, arg := range []string{`"abc`, `"a"b"`, `"`} {
+		t.Run("invalid "+arg, func(t *testing.T) {
+			_, err := xtd.UnquoteCSVField(arg)
+			assert.ErrorIs(t, err, xtd.ErrInvalidEscape)
+		})
+	}
+}
+
+func TestEscapeHTMLAttr(t *testing.T) {
+	arg := `<a href="x">Tom & Jerry's</a>`
+	want := "&lt;a href=&#34;x&#34;&gt;Tom &amp; Jerry&#39;s&lt;/a&gt;"
+
+	assert.Equal(t, want, xtd.EscapeHTMLAttr(arg))
+	assert.Equal(t, arg, xtd.UnescapeHTMLAttr(want))
+}
@@ -1,17 +1,12 @@
 package xtd
 
-import (
-	"sort"
-)
-
 // FilterFn is any function which takes
 // a single input and returns a boolean.
 type FilterFn[T any] func(T) bool
 
 // FilterSlice returns a newly allocated slice containing all
 // entries from the passed slice for which the passed FilterFn returns true.
-// This function does not gauarantee that the ordering of entries
-// in the resulting slice is in any way similar to the input slice.
+// Entries in the resulting slice keep their ordering from the passed slice.
 func FilterSlice[T any](data []T, fn FilterFn[T]) (res []T) {
 	res = make([]T, 0, len(data))
 
@@ -27,24 +22,26 @@ func FilterSlice[T any](data []T, fn FilterFn[T]) (res []T) {
 // FilterSliceSafe returns a newly allocated slice containing all
 // entries from the passed slice for which the passed FilterFn returns true.
 // This function does gauarantee that the ordering of entries
-// in the resulting slice is respective of the ordering of the input slice,
-// at the cost of a tad bit extra computational complexity.
+// in the resulting slice is respective of the ordering of the input slice.
+// It is identical to FilterSlice.
 func FilterSliceSafe[T any](data []T, fn FilterFn[T]) (res []T) {
-	var entries sliceEntries[T]
+	return FilterSlice(data, fn)
+}
+
+// FilterInPlace removes all entries from the passed slice for which the passed FilterFn
+// returns false, keeping the remaining entries in their original order,
+// and returns the compacted slice, which shares the passed slice's backing array.
+// The entries beyond the returned slice's length are zeroed,
+// so that they do not keep any referenced memory alive.
+func FilterInPlace[T any](data []T, fn FilterFn[T]) []T {
+	n := 0
 
-	for i, d := range data {
+	for _, d := range data {
 		if fn(d) {
-			entries = append(entries, sliceEntry[T]{d, i})
+			data[n] = d
+			n++
 		}
 	}
 
-	res = make([]T, len(entries))
-
-	sort.Sort(entries)
-
-	for i := range entries {
-		res[i] = entries[i].d
-	}
-
-	return
+	return zeroTail(data, n)
 }
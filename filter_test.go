@@ -0,0 +1,66 @@
+package xtd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+// benchmarkFilterData returns 10,000 entries, of which
+// the passed number are distinct, spread throughout the slice.
+func benchmarkFilterData(distinct int) []int {
+	data := make([]int, 10_000)
+	for i := range data {
+		data[i] = i * 7919 % distinct
+	}
+
+	return data
+}
+
+func isEven(n int) bool {
+	return n%2 == 0
+}
+
+func BenchmarkFilterSliceSafe(b *testing.B) {
+	data := benchmarkFilterData(10_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = xtd.FilterSliceSafe(data, isEven)
+	}
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	data := benchmarkFilterData(10_000)
+	buf := make([]int, len(data))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		copy(buf, data)
+		_ = xtd.FilterInPlace(buf, isEven)
+	}
+}
+
+func TestFilterSliceSafe(t *testing.T) {
+	got := xtd.FilterSliceSafe([]int{5, 2, 8, 3, 4}, isEven)
+	assert.Equal(t, []int{2, 8, 4}, got)
+}
+
+func TestFilterInPlace(t *testing.T) {
+	a, b, c := "a", "b", "c"
+	data := []*string{&a, nil, &b, nil, &c}
+
+	got := xtd.FilterInPlace(data, func(s *string) bool {
+		return s != nil
+	})
+
+	assert.Equal(t, []*string{&a, &b, &c}, got)
+	assert.Equal(t, []*string{&a, &b, &c, nil, nil}, data, "tail should be zeroed")
+	assert.Same(t, &data[0], &got[0])
+}
@@ -36,6 +36,22 @@ type (
 	// UnaryCtxErrFn is any function which takes a context.Context
 	// and single input, then returns a single output along with an error.
 	UnaryCtxErrFn[T, U any] func(context.Context, T) (U, error)
+
+	// BinaryFn is any function which takes two inputs
+	// of types T and U, and returns a single output.
+	BinaryFn[T, U, V any] func(T, U) V
+
+	// BinaryCtxFn is any function which takes a context.Context
+	// and two inputs, and returns a single output.
+	BinaryCtxFn[T, U, V any] func(context.Context, T, U) V
+
+	// BinaryErrFn is any function which takes two inputs,
+	// and returns a single output along with an error.
+	BinaryErrFn[T, U, V any] func(T, U) (V, error)
+
+	// BinaryCtxErrFn is any function which takes a context.Context
+	// and two inputs, then returns a single output along with an error.
+	BinaryCtxErrFn[T, U, V any] func(context.Context, T, U) (V, error)
 )
 
 // ErrFn "wraps" a Fn in a function with a ErrFn signature.
@@ -70,3 +86,19 @@ func (fn UnaryCtxFn[T, U]) ErrFn() UnaryCtxErrFn[T, U] {
 		return fn(ctx, data), nil
 	}
 }
+
+// ErrFn "wraps" a BinaryFn in a function with a BinaryErrFn signature.
+// The error returned by the wrapper function will always be nil.
+func (fn BinaryFn[T, U, V]) ErrFn() BinaryErrFn[T, U, V] {
+	return func(a T, b U) (V, error) {
+		return fn(a, b), nil
+	}
+}
+
+// ErrFn "wraps" a BinaryCtxFn in a function with a BinaryCtxErrFn signature.
+// The error returned by the wrapper function will always be nil.
+func (fn BinaryCtxFn[T, U, V]) ErrFn() BinaryCtxErrFn[T, U, V] {
+	return func(ctx context.Context, a T, b U) (V, error) {
+		return fn(ctx, a, b), nil
+	}
+}
@@ -0,0 +1,180 @@
+package xtd
+
+import (
+	"context"
+)
+
+// Reduce combines all entries in the passed slice into a single value, from left to right,
+// by calling the passed BinaryFn with the result so far and the next entry,
+// starting with the first entry as the result (Reduce([1, 2, 3], add) == (1+2)+3).
+// If the slice is empty, the zero value of T is returned.
+func Reduce[T any](data []T, fn BinaryFn[T, T, T]) (res T) {
+	res, _ = ReduceError(data, fn.ErrFn())
+	return
+}
+
+// ReduceError is identical to Reduce, except that the passed function may return an error.
+// If it does, the zero value of T and that error are returned.
+func ReduceError[T any](data []T, fn BinaryErrFn[T, T, T]) (res T, err error) {
+	return ReduceContextError(context.Background(), data, func(_ context.Context, acc, d T) (T, error) {
+		return fn(acc, d)
+	})
+}
+
+// ReduceContext is identical to Reduce, except that the passed function is passed a context.Context,
+// which is checked before each entry; if it is done, the zero value of T
+// and an *IndexError wrapping ctx.Err() and recording the entry reached are returned.
+func ReduceContext[T any](ctx context.Context, data []T, fn BinaryCtxFn[T, T, T]) (res T, err error) {
+	return ReduceContextError(ctx, data, fn.ErrFn())
+}
+
+// ReduceContextError is identical to ReduceContext, except that the passed function may return an error.
+// If it does, the zero value of T and that error are returned.
+func ReduceContextError[T any](ctx context.Context, data []T, fn BinaryCtxErrFn[T, T, T]) (res T, err error) {
+	if len(data) == 0 {
+		return
+	}
+
+	acc := data[0]
+
+	for i := 1; i < len(data); i++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return res, &IndexError{Index: i, Err: ctxErr}
+		}
+
+		if acc, err = fn(ctx, acc, data[i]); err != nil {
+			return res, err
+		}
+	}
+
+	return acc, nil
+}
+
+// FoldLeft combines all entries in the passed slice into a single value, from left to right,
+// by calling the passed BinaryFn with the result so far and the next entry,
+// starting with init as the result (FoldLeft([1, 2, 3], 0, add) == ((0+1)+2)+3).
+// If the slice is empty, init is returned.
+func FoldLeft[T, A any](data []T, init A, fn BinaryFn[A, T, A]) (res A) {
+	res, _ = FoldLeftError(data, init, fn.ErrFn())
+	return
+}
+
+// FoldLeftError is identical to FoldLeft, except that the passed function may return an error.
+// If it does, the zero value of A and that error are returned.
+func FoldLeftError[T, A any](data []T, init A, fn BinaryErrFn[A, T, A]) (res A, err error) {
+	return FoldLeftContextError(context.Background(), data, init, func(_ context.Context, acc A, d T) (A, error) {
+		return fn(acc, d)
+	})
+}
+
+// FoldLeftContext is identical to FoldLeft, except that the passed function is passed a context.Context,
+// which is checked before each entry; if it is done, the zero value of A
+// and an *IndexError wrapping ctx.Err() and recording the entry reached are returned.
+func FoldLeftContext[T, A any](ctx context.Context, data []T, init A, fn BinaryCtxFn[A, T, A]) (res A, err error) {
+	return FoldLeftContextError(ctx, data, init, fn.ErrFn())
+}
+
+// FoldLeftContextError is identical to FoldLeftContext, except that the passed function may return an error.
+// If it does, the zero value of A and that error are returned.
+func FoldLeftContextError[T, A any](ctx context.Context, data []T, init A, fn BinaryCtxErrFn[A, T, A]) (res A, err error) {
+	acc := init
+
+	for i, d := range data {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return res, &IndexError{Index: i, Err: ctxErr}
+		}
+
+		if acc, err = fn(ctx, acc, d); err != nil {
+			return res, err
+		}
+	}
+
+	return acc, nil
+}
+
+// FoldRight combines all entries in the passed slice into a single value, from right to left,
+// by calling the passed BinaryFn with the next entry and the result so far,
+// starting with init as the result (FoldRight([1, 2, 3], 0, add) == 1+(2+(3+0))).
+// If the slice is empty, init is returned.
+func FoldRight[T, A any](data []T, init A, fn BinaryFn[T, A, A]) (res A) {
+	res, _ = FoldRightError(data, init, fn.ErrFn())
+	return
+}
+
+// FoldRightError is identical to FoldRight, except that the passed function may return an error.
+// If it does, the zero value of A and that error are returned.
+func FoldRightError[T, A any](data []T, init A, fn BinaryErrFn[T, A, A]) (res A, err error) {
+	return FoldRightContextError(context.Background(), data, init, func(_ context.Context, d T, acc A) (A, error) {
+		return fn(d, acc)
+	})
+}
+
+// FoldRightContext is identical to FoldRight, except that the passed function is passed a context.Context,
+// which is checked before each entry; if it is done, the zero value of A
+// and an *IndexError wrapping ctx.Err() and recording the entry reached are returned.
+func FoldRightContext[T, A any](ctx context.Context, data []T, init A, fn BinaryCtxFn[T, A, A]) (res A, err error) {
+	return FoldRightContextError(ctx, data, init, fn.ErrFn())
+}
+
+// FoldRightContextError is identical to FoldRightContext, except that the passed function may return an error.
+// If it does, the zero value of A and that error are returned.
+func FoldRightContextError[T, A any](ctx context.Context, data []T, init A, fn BinaryCtxErrFn[T, A, A]) (res A, err error) {
+	acc := init
+
+	for i := len(data) - 1; i >= 0; i-- {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return res, &IndexError{Index: i, Err: ctxErr}
+		}
+
+		if acc, err = fn(ctx, data[i], acc); err != nil {
+			return res, err
+		}
+	}
+
+	return acc, nil
+}
+
+// Scan is identical to FoldLeft, except that it returns a newly allocated slice
+// of the same length as the passed slice containing every intermediate result,
+// such that res[i] is the result of folding data[:i+1]
+// (Scan([1, 2, 3], 0, add) == [1, 3, 6]).
+func Scan[T, A any](data []T, init A, fn BinaryFn[A, T, A]) (res []A) {
+	res, _ = ScanError(data, init, fn.ErrFn())
+	return
+}
+
+// ScanError is identical to Scan, except that the passed function may return an error.
+// If it does, a nil slice and that error are returned.
+func ScanError[T, A any](data []T, init A, fn BinaryErrFn[A, T, A]) (res []A, err error) {
+	return ScanContextError(context.Background(), data, init, func(_ context.Context, acc A, d T) (A, error) {
+		return fn(acc, d)
+	})
+}
+
+// ScanContext is identical to Scan, except that the passed function is passed a context.Context,
+// which is checked before each entry; if it is done, a nil slice
+// and an *IndexError wrapping ctx.Err() and recording the entry reached are returned.
+func ScanContext[T, A any](ctx context.Context, data []T, init A, fn BinaryCtxFn[A, T, A]) (res []A, err error) {
+	return ScanContextError(ctx, data, init, fn.ErrFn())
+}
+
+// ScanContextError is identical to ScanContext, except that the passed function may return an error.
+// If it does, a nil slice and that error are returned.
+func ScanContextError[T, A any](ctx context.Context, data []T, init A, fn BinaryCtxErrFn[A, T, A]) (res []A, err error) {
+	res = make([]A, len(data))
+	acc := init
+
+	for i, d := range data {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, &IndexError{Index: i, Err: ctxErr}
+		}
+
+		if acc, err = fn(ctx, acc, d); err != nil {
+			return nil, err
+		}
+
+		res[i] = acc
+	}
+
+	return res, nil
+}
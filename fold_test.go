@@ -0,0 +1,140 @@
+package xtd_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/xtd"
+)
+
+func addInts(a, b int) int {
+	return a + b
+}
+
+var errFoldNegative = errors.New("negative")
+
+func addNonNegative(a, b int) (int, error) {
+	if b < 0 {
+		return 0, errFoldNegative
+	}
+
+	return a + b, nil
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  []int
+		want int
+	}{
+		{"sum", []int{1, 2, 3, 4}, 10},
+		{"single", []int{7}, 7},
+		{"empty", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Reduce(tt.arg, addInts))
+		})
+	}
+
+	t.Run("order", func(t *testing.T) {
+		got := xtd.Reduce([]string{"a", "b", "c"}, func(acc, s string) string {
+			return "(" + acc + s + ")"
+		})
+		assert.Equal(t, "((ab)c)", got)
+	})
+}
+
+func TestReduceError(t *testing.T) {
+	got, err := xtd.ReduceError([]int{1, 2, 3}, addNonNegative)
+	require.NoError(t, err)
+	assert.Equal(t, 6, got)
+
+	got, err = xtd.ReduceError([]int{1, -2, 3}, addNonNegative)
+	assert.ErrorIs(t, err, errFoldNegative)
+	assert.Zero(t, got)
+}
+
+func TestReduceContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	got, err := xtd.ReduceContext(ctx, []int{1, 2, 3, 4}, func(_ context.Context, a, b int) int {
+		if b == 2 {
+			cancel()
+		}
+
+		return a + b
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, got)
+
+	var idxErr *xtd.IndexError
+	require.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 2, idxErr.Index)
+}
+
+func TestFoldLeft(t *testing.T) {
+	got := xtd.FoldLeft([]int{1, 2, 3}, "0", func(acc string, n int) string {
+		return "(" + acc + "+" + strconv.Itoa(n) + ")"
+	})
+	assert.Equal(t, "(((0+1)+2)+3)", got)
+
+	assert.Equal(t, "init", xtd.FoldLeft(nil, "init", func(acc string, n int) string {
+		return acc + strconv.Itoa(n)
+	}))
+
+	sum, err := xtd.FoldLeftError([]int{1, -2}, 0, addNonNegative)
+	assert.ErrorIs(t, err, errFoldNegative)
+	assert.Zero(t, sum)
+
+	sum, err = xtd.FoldLeftContext(context.Background(), []int{1, 2, 3}, 10, func(_ context.Context, a, b int) int {
+		return a + b
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 16, sum)
+}
+
+func TestFoldRight(t *testing.T) {
+	got := xtd.FoldRight([]int{1, 2, 3}, "0", func(n int, acc string) string {
+		return "(" + strconv.Itoa(n) + "+" + acc + ")"
+	})
+	assert.Equal(t, "(1+(2+(3+0)))", got)
+
+	_, err := xtd.FoldRightError([]int{-1, 2}, 0, func(n, acc int) (int, error) {
+		return addNonNegative(acc, n)
+	})
+	assert.ErrorIs(t, err, errFoldNegative)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = xtd.FoldRightContext(ctx, []int{1, 2, 3}, 0, func(_ context.Context, n, acc int) int {
+		return n + acc
+	})
+
+	var idxErr *xtd.IndexError
+	require.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 2, idxErr.Index)
+}
+
+func TestScan(t *testing.T) {
+	assert.Equal(t, []int{1, 3, 6, 10}, xtd.Scan([]int{1, 2, 3, 4}, 0, addInts))
+	assert.Empty(t, xtd.Scan(nil, 0, addInts))
+
+	got, err := xtd.ScanError([]int{1, 2, -3}, 0, addNonNegative)
+	assert.ErrorIs(t, err, errFoldNegative)
+	assert.Nil(t, got)
+
+	got, err = xtd.ScanContextError(context.Background(), []int{1, 2, 3}, 0, func(_ context.Context, a, b int) (int, error) {
+		return addNonNegative(a, b)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 3, 6}, got)
+}
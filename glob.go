@@ -0,0 +1,292 @@
+package xtd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// GlobOptions configures how a glob pattern is compiled by CompileGlobWithOptions.
+type GlobOptions struct {
+	// CaseInsensitive makes the pattern match regardless of letter case.
+	CaseInsensitive bool
+	// Separator, if non-zero, is a rune which '*', '?' and negated character
+	// classes will not match, while '**' will. Typically '/' for paths.
+	Separator rune
+}
+
+// GlobSyntaxError is returned when compiling a malformed glob pattern,
+// recording the byte offset in the pattern at which the problem was found.
+type GlobSyntaxError struct {
+	Pattern string
+	Msg     string
+	Pos     int
+}
+
+func (e *GlobSyntaxError) Error() string {
+	return fmt.Sprintf("glob %q: %s at position %d", e.Pattern, e.Msg, e.Pos)
+}
+
+// Glob is a compiled wildcard pattern. The following syntax is supported:
+//
+//	Pattern    Matches
+//	*          any sequence of runes, not including the separator (if any)
+//	**         any sequence of runes, including the separator; as a whole segment
+//	           ("a/**/b"), zero or more segments, so "a/**/b" also matches "a/b"
+//	?          any single rune other than the separator
+//	[abc]      any one of the listed runes; ranges ([a-z]) are allowed
+//	[!abc]     any one rune not listed, nor the separator ([^abc] is also accepted)
+//	{a,b,c}    any one of the comma-separated alternatives, which may themselves be patterns
+//	\x         the rune x, literally
+//
+// A Glob must match the entire input string, not just part of it.
+// A Glob is safe for concurrent use.
+type Glob struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// CompileGlob compiles the passed glob pattern with the default GlobOptions:
+// case-sensitive and without a separator, meaning '*' and '**' behave identically.
+func CompileGlob(pattern string) (*Glob, error) {
+	return CompileGlobWithOptions(pattern, GlobOptions{})
+}
+
+// MustCompileGlob is identical to CompileGlob, but panics if the pattern is malformed.
+func MustCompileGlob(pattern string) *Glob {
+	g, err := CompileGlob(pattern)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+// CompileGlobWithOptions compiles the passed glob pattern, configured by the passed GlobOptions.
+// If the pattern is malformed, a *GlobSyntaxError is returned.
+func CompileGlobWithOptions(pattern string, opts GlobOptions) (*Glob, error) {
+	c := globCompiler{
+		pattern: pattern,
+		opts:    opts,
+	}
+
+	var sb strings.Builder
+
+	if opts.CaseInsensitive {
+		sb.WriteString("(?i)")
+	}
+
+	sb.WriteString(`^(?s:`)
+
+	if err := c.compile(&sb, 0); err != nil {
+		return nil, err
+	}
+
+	sb.WriteString(`)$`)
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, &GlobSyntaxError{pattern, err.Error(), 0}
+	}
+
+	return &Glob{pattern, re}, nil
+}
+
+// Match reports whether the passed string matches the Glob in its entirety.
+func (g *Glob) Match(s string) bool {
+	return g.re.MatchString(s)
+}
+
+// String returns the source pattern of the Glob.
+func (g *Glob) String() string {
+	return g.pattern
+}
+
+// FilterFn returns a FilterFn which reports whether its input matches the Glob,
+// for use with FilterSlice and FilterSliceSafe.
+func (g *Glob) FilterFn() FilterFn[string] {
+	return g.Match
+}
+
+type globCompiler struct {
+	pattern string
+	opts    GlobOptions
+	pos     int
+}
+
+// compile translates the pattern into regular expression syntax, starting at c.pos,
+// until the end of the pattern or, inside braces (depth > 0), an unmatched ',' or '}',
+// which is left for compileAlternation to consume.
+func (c *globCompiler) compile(sb *strings.Builder, depth int) error {
+	for c.pos < len(c.pattern) {
+		ch := c.pattern[c.pos]
+
+		switch ch {
+		case '*':
+			if strings.HasPrefix(c.pattern[c.pos:], "**") {
+				atSegmentStart := c.atSegmentStart()
+				c.pos += 2
+
+				for c.pos < len(c.pattern) && c.pattern[c.pos] == '*' {
+					c.pos++
+				}
+
+				// A '**' segment followed by a separator may match zero segments,
+				// so "a/**/b" matches "a/b" as well as "a/x/b".
+				if r, size := utf8.DecodeRuneInString(c.pattern[c.pos:]); atSegmentStart && size > 0 && r == c.opts.Separator {
+					sb.WriteString(`(?:.*` + regexp.QuoteMeta(string(r)) + `)?`)
+					c.pos += size
+
+					continue
+				}
+
+				sb.WriteString(`.*`)
+
+				continue
+			}
+
+			sb.WriteString(c.anyRune() + `*`)
+		case '?':
+			sb.WriteString(c.anyRune())
+		case '[':
+			if err := c.compileClass(sb); err != nil {
+				return err
+			}
+
+			continue
+		case '{':
+			if err := c.compileAlternation(sb, depth); err != nil {
+				return err
+			}
+
+			continue
+		case ',', '}':
+			if depth > 0 {
+				return nil
+			}
+
+			if ch == '}' {
+				return &GlobSyntaxError{c.pattern, "unmatched '}'", c.pos}
+			}
+
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		case '\\':
+			if c.pos+1 == len(c.pattern) {
+				return &GlobSyntaxError{c.pattern, "trailing backslash", c.pos}
+			}
+
+			c.pos++
+
+			r, size := utf8.DecodeRuneInString(c.pattern[c.pos:])
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			c.pos += size
+
+			continue
+		default:
+			r, size := utf8.DecodeRuneInString(c.pattern[c.pos:])
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			c.pos += size
+
+			continue
+		}
+
+		c.pos++
+	}
+
+	return nil
+}
+
+// atSegmentStart reports whether c.pos is at the start of the pattern or follows a separator.
+func (c *globCompiler) atSegmentStart() bool {
+	if c.opts.Separator == 0 {
+		return false
+	}
+
+	if c.pos == 0 {
+		return true
+	}
+
+	r, _ := utf8.DecodeLastRuneInString(c.pattern[:c.pos])
+
+	return r == c.opts.Separator
+}
+
+func (c *globCompiler) compileAlternation(sb *strings.Builder, depth int) error {
+	start := c.pos
+	c.pos++
+
+	sb.WriteString(`(?:`)
+
+	for {
+		if err := c.compile(sb, depth+1); err != nil {
+			return err
+		}
+
+		if c.pos == len(c.pattern) {
+			return &GlobSyntaxError{c.pattern, "unterminated '{'", start}
+		}
+
+		ch := c.pattern[c.pos]
+		c.pos++
+
+		if ch == '}' {
+			break
+		}
+
+		sb.WriteByte('|')
+	}
+
+	sb.WriteByte(')')
+
+	return nil
+}
+
+func (c *globCompiler) compileClass(sb *strings.Builder) error {
+	start := c.pos
+	c.pos++
+
+	sb.WriteByte('[')
+
+	if c.pos < len(c.pattern) && (c.pattern[c.pos] == '!' || c.pattern[c.pos] == '^') {
+		sb.WriteByte('^')
+
+		if c.opts.Separator != 0 {
+			fmt.Fprintf(sb, `\x{%x}`, c.opts.Separator)
+		}
+
+		c.pos++
+	}
+
+	for first := true; c.pos < len(c.pattern); first = false {
+		ch := c.pattern[c.pos]
+
+		switch {
+		case ch == ']' && !first:
+			c.pos++
+			sb.WriteByte(']')
+
+			return nil
+		case ch == '-' && !first && c.pos+1 < len(c.pattern) && c.pattern[c.pos+1] != ']':
+			sb.WriteByte('-')
+			c.pos++
+		case ch == '\\' && c.pos+1 < len(c.pattern):
+			c.pos++
+			fallthrough
+		default:
+			r, size := utf8.DecodeRuneInString(c.pattern[c.pos:])
+			fmt.Fprintf(sb, `\x{%x}`, r)
+			c.pos += size
+		}
+	}
+
+	return &GlobSyntaxError{c.pattern, "unterminated '['", start}
+}
+
+func (c *globCompiler) anyRune() string {
+	if c.opts.Separator == 0 {
+		return `.`
+	}
+
+	return `[^` + regexp.QuoteMeta(string(c.opts.Separator)) + `]`
+}
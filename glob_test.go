@@ -0,0 +1,155 @@
+package xtd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestGlob_Match(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		opts    xtd.GlobOptions
+		matches []string
+		misses  []string
+	}{
+		{
+			name:    "star and question",
+			pattern: "prod-*-db?",
+			matches: []string{"prod-users-db1", "prod--dbx", "prod-a-b-db2"},
+			misses:  []string{"prod-users-db", "prod-users-db12", "staging-users-db1"},
+		},
+		{
+			name:    "braces and double star",
+			pattern: "{api,web}-**",
+			matches: []string{"api-1", "web-a/b/c", "api-"},
+			misses:  []string{"worker-1", "apiweb-1"},
+		},
+		{
+			name:    "nested braces",
+			pattern: "{a,b{c,d}}x",
+			matches: []string{"ax", "bcx", "bdx"},
+			misses:  []string{"bx", "abx"},
+		},
+		{
+			name:    "empty alternative",
+			pattern: "file{,.bak}",
+			matches: []string{"file", "file.bak"},
+			misses:  []string{"file.txt"},
+		},
+		{
+			name:    "character classes",
+			pattern: "node[0-9][!a-c]",
+			matches: []string{"node1d", "node9-"},
+			misses:  []string{"nodea1", "node1b"},
+		},
+		{
+			name:    "escapes",
+			pattern: `a\*b[\]]`,
+			matches: []string{"a*b]"},
+			misses:  []string{"axb]"},
+		},
+		{
+			name:    "regexp metacharacters",
+			pattern: "v1.0(+x)|$",
+			matches: []string{"v1.0(+x)|$"},
+			misses:  []string{"v100(+x)|$"},
+		},
+		{
+			name:    "separator",
+			pattern: "logs/*/app.log",
+			opts:    xtd.GlobOptions{Separator: '/'},
+			matches: []string{"logs/2024/app.log"},
+			misses:  []string{"logs/2024/01/app.log"},
+		},
+		{
+			name:    "separator double star",
+			pattern: "logs/**/app.log",
+			opts:    xtd.GlobOptions{Separator: '/'},
+			matches: []string{"logs/2024/app.log", "logs/2024/01/app.log", "logs/app.log"},
+			misses:  []string{"logs/app.txt", "logsapp.log", "logs2024/app.log"},
+		},
+		{
+			name:    "separator leading double star",
+			pattern: "**/app.log",
+			opts:    xtd.GlobOptions{Separator: '/'},
+			matches: []string{"app.log", "logs/app.log", "logs/2024/app.log"},
+			misses:  []string{"xapp.log", "logs/xapp.log"},
+		},
+		{
+			name:    "separator double star within segment",
+			pattern: "logs/a**/app.log",
+			opts:    xtd.GlobOptions{Separator: '/'},
+			matches: []string{"logs/a/app.log", "logs/ab/c/app.log"},
+			misses:  []string{"logs/app.log"},
+		},
+		{
+			name:    "case insensitive",
+			pattern: "PROD-*",
+			opts:    xtd.GlobOptions{CaseInsensitive: true},
+			matches: []string{"prod-db", "Prod-Web"},
+			misses:  []string{"staging-db"},
+		},
+		{
+			name:    "unicode",
+			pattern: "日?語",
+			matches: []string{"日本語"},
+			misses:  []string{"日語"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := xtd.CompileGlobWithOptions(tt.pattern, tt.opts)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, s := range tt.matches {
+				assert.True(t, g.Match(s), "expected %q to match %q", tt.pattern, s)
+			}
+
+			for _, s := range tt.misses {
+				assert.False(t, g.Match(s), "expected %q not to match %q", tt.pattern, s)
+			}
+		})
+	}
+}
+
+func TestCompileGlob_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		wantPos int
+	}{
+		{"unterminated class", "node[0-9", 4},
+		{"unterminated brace", "{api,web", 0},
+		{"unterminated nested brace", "{a,{b,c}", 0},
+		{"unmatched brace", "api}", 3},
+		{"trailing backslash", `api\`, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := xtd.CompileGlob(tt.pattern)
+			assert.Nil(t, g)
+
+			var syntaxErr *xtd.GlobSyntaxError
+			if assert.ErrorAs(t, err, &syntaxErr) {
+				assert.Equal(t, tt.wantPos, syntaxErr.Pos)
+			}
+		})
+	}
+
+	assert.Panics(t, func() { xtd.MustCompileGlob("[") })
+}
+
+func TestGlob_FilterFn(t *testing.T) {
+	names := []string{"prod-users-db1", "staging-users-db1", "prod-cache-db2", "prod-web"}
+
+	got := xtd.FilterSlice(names, xtd.MustCompileGlob("prod-*-db?").FilterFn())
+	assert.Equal(t, []string{"prod-users-db1", "prod-cache-db2"}, got)
+}
@@ -0,0 +1,164 @@
+package xtd
+
+// Group is a single group of entries sharing a key, as produced by GroupBy.
+type Group[K comparable, T any] struct {
+	Key    K
+	Values []T
+}
+
+// GroupMap holds the groups produced by GroupBy, ordered by
+// the first appearance of each group's key in the input slice.
+type GroupMap[K comparable, T any] struct {
+	index  map[K]int
+	groups []Group[K, T]
+}
+
+// Len returns the number of groups.
+func (g *GroupMap[K, T]) Len() int {
+	return len(g.groups)
+}
+
+// Keys returns a newly allocated slice containing the key of every group, in order.
+func (g *GroupMap[K, T]) Keys() []K {
+	return MapSlice(g.groups, func(group Group[K, T]) K {
+		return group.Key
+	})
+}
+
+// Get returns the entries of the group with the passed key,
+// and whether such a group exists.
+func (g *GroupMap[K, T]) Get(key K) ([]T, bool) {
+	i, ok := g.index[key]
+	if !ok {
+		return nil, false
+	}
+
+	return g.groups[i].Values, true
+}
+
+// Groups returns a newly allocated slice containing every group, in order.
+// The groups' Values slices are shared with the GroupMap.
+func (g *GroupMap[K, T]) Groups() []Group[K, T] {
+	return append([]Group[K, T](nil), g.groups...)
+}
+
+// Map returns the groups as a newly allocated map of key to entries,
+// losing their ordering. The entry slices are shared with the GroupMap.
+func (g *GroupMap[K, T]) Map() map[K][]T {
+	m := make(map[K][]T, len(g.groups))
+	for _, group := range g.groups {
+		m[group.Key] = group.Values
+	}
+
+	return m
+}
+
+// GroupBy groups the entries of the passed slice by the key returned for each of them
+// by the passed UnaryFn. Groups are ordered by the first appearance of their key,
+// and entries within a group keep their order from the passed slice.
+// Each group's entries are copied into a newly allocated slice,
+// and do not alias the passed slice.
+func GroupBy[T any, K comparable](data []T, keyFn UnaryFn[T, K]) *GroupMap[K, T] {
+	g := &GroupMap[K, T]{index: make(map[K]int)}
+
+	for _, d := range data {
+		key := keyFn(d)
+
+		i, ok := g.index[key]
+		if !ok {
+			i = len(g.groups)
+			g.index[key] = i
+			g.groups = append(g.groups, Group[K, T]{Key: key})
+		}
+
+		g.groups[i].Values = append(g.groups[i].Values, d)
+	}
+
+	return g
+}
+
+// Partition splits the passed slice into the entries for which the passed FilterFn
+// returns true (kept) and those for which it returns false (rejected),
+// both in the order they appear in the passed slice.
+// Both are newly allocated slices, and do not alias the passed slice.
+func Partition[T any](data []T, fn FilterFn[T]) (kept, rejected []T) {
+	kept, rejected = make([]T, 0, len(data)), make([]T, 0)
+
+	for _, d := range data {
+		if fn(d) {
+			kept = append(kept, d)
+		} else {
+			rejected = append(rejected, d)
+		}
+	}
+
+	return
+}
+
+// Chunk splits the passed slice into consecutive chunks of n entries,
+// the last of which holds the remainder and may be shorter.
+// The chunks are subslices which alias the passed slice's backing array, so writes to
+// their entries are visible in the passed slice; their capacity is limited to their length,
+// so appending to a chunk never overwrites the entries following it.
+// Chunk panics if n is less than 1.
+func Chunk[T any](data []T, n int) [][]T {
+	if n < 1 {
+		panic("xtd: Chunk size must be at least 1")
+	}
+
+	res := make([][]T, 0, (len(data)+n-1)/n)
+
+	for start := 0; start < len(data); start += n {
+		end := minOf(start+n, len(data))
+		res = append(res, data[start:end:end])
+	}
+
+	return res
+}
+
+// Window returns every window of size consecutive entries of the passed slice,
+// with each window starting step entries after the previous one
+// (Window([1, 2, 3, 4, 5], 3, 1) == [[1, 2, 3], [2, 3, 4], [3, 4, 5]]).
+// Only complete windows are returned, so a slice shorter than size yields none,
+// and trailing entries not reached by a step are omitted.
+// As with Chunk, the windows alias the passed slice's backing array
+// and have their capacity limited to their length.
+// Window panics if size or step is less than 1.
+func Window[T any](data []T, size, step int) [][]T {
+	if size < 1 || step < 1 {
+		panic("xtd: Window size and step must be at least 1")
+	}
+
+	if len(data) < size {
+		return [][]T{}
+	}
+
+	res := make([][]T, 0, (len(data)-size)/step+1)
+
+	for start := 0; start+size <= len(data); start += step {
+		res = append(res, data[start:start+size:start+size])
+	}
+
+	return res
+}
+
+// SplitWhen splits the passed slice between each pair of adjacent entries
+// for which the passed BinaryFn returns true, such as where a sorted sequence
+// of timestamps has a gap (SplitWhen([1, 2, 4, 5], func(a, b int) bool { return b-a > 1 })
+// == [[1, 2], [4, 5]]). An empty slice yields no runs.
+// As with Chunk, the runs alias the passed slice's backing array
+// and have their capacity limited to their length.
+func SplitWhen[T any](data []T, fn BinaryFn[T, T, bool]) [][]T {
+	res := [][]T{}
+
+	start := 0
+
+	for i := 1; i <= len(data); i++ {
+		if i == len(data) || fn(data[i-1], data[i]) {
+			res = append(res, data[start:i:i])
+			start = i
+		}
+	}
+
+	return res
+}
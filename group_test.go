@@ -0,0 +1,144 @@
+package xtd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestGroupBy(t *testing.T) {
+	words := []string{"apple", "bob", "avocado", "cat", "banana", "axe"}
+
+	g := xtd.GroupBy(words, func(s string) byte {
+		return s[0]
+	})
+
+	assert.Equal(t, 3, g.Len())
+	assert.Equal(t, []byte{'a', 'b', 'c'}, g.Keys())
+
+	apples, ok := g.Get('a')
+	assert.True(t, ok)
+	assert.Equal(t, []string{"apple", "avocado", "axe"}, apples)
+
+	_, ok = g.Get('z')
+	assert.False(t, ok)
+
+	assert.Equal(t, []xtd.Group[byte, string]{
+		{Key: 'a', Values: []string{"apple", "avocado", "axe"}},
+		{Key: 'b', Values: []string{"bob", "banana"}},
+		{Key: 'c', Values: []string{"cat"}},
+	}, g.Groups())
+
+	assert.Equal(t, map[byte][]string{
+		'a': {"apple", "avocado", "axe"},
+		'b': {"bob", "banana"},
+		'c': {"cat"},
+	}, g.Map())
+
+	t.Run("does not alias", func(t *testing.T) {
+		data := []int{1, 2, 3}
+		g := xtd.GroupBy(data, func(int) bool { return true })
+
+		all, _ := g.Get(true)
+		all[0] = 100
+
+		assert.Equal(t, 1, data[0])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		g := xtd.GroupBy(nil, strings.ToLower)
+		assert.Zero(t, g.Len())
+		assert.Empty(t, g.Keys())
+	})
+}
+
+func TestPartition(t *testing.T) {
+	kept, rejected := xtd.Partition([]int{1, 2, 3, 4, 5, 6}, func(n int) bool {
+		return n%2 == 0
+	})
+
+	assert.Equal(t, []int{2, 4, 6}, kept)
+	assert.Equal(t, []int{1, 3, 5}, rejected)
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  []int
+		n    int
+		want [][]int
+	}{
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"larger than slice", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty", nil, 3, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Chunk(tt.arg, tt.n))
+		})
+	}
+
+	t.Run("aliasing", func(t *testing.T) {
+		data := []int{1, 2, 3, 4}
+		chunks := xtd.Chunk(data, 2)
+
+		chunks[0][0] = 100
+		assert.Equal(t, 100, data[0])
+
+		_ = append(chunks[0], 200)
+		assert.Equal(t, 3, data[2])
+	})
+
+	assert.Panics(t, func() { xtd.Chunk([]int{1}, 0) })
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		arg        []int
+		size, step int
+		want       [][]int
+	}{
+		{"sliding", []int{1, 2, 3, 4, 5}, 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{"stepped", []int{1, 2, 3, 4, 5, 6}, 2, 2, [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{"incomplete tail omitted", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+		{"step larger than size", []int{1, 2, 3, 4, 5}, 1, 3, [][]int{{1}, {4}}},
+		{"too short", []int{1, 2}, 3, 1, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Window(tt.arg, tt.size, tt.step))
+		})
+	}
+
+	assert.Panics(t, func() { xtd.Window([]int{1}, 1, 0) })
+}
+
+func TestSplitWhen(t *testing.T) {
+	gap := func(a, b int) bool {
+		return b-a > 1
+	}
+
+	tests := []struct {
+		name string
+		arg  []int
+		want [][]int
+	}{
+		{"gaps", []int{1, 2, 4, 5, 6, 9}, [][]int{{1, 2}, {4, 5, 6}, {9}}},
+		{"no gaps", []int{1, 2, 3}, [][]int{{1, 2, 3}}},
+		{"single", []int{1}, [][]int{{1}}},
+		{"empty", nil, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.SplitWhen(tt.arg, gap))
+		})
+	}
+}
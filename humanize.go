@@ -0,0 +1,262 @@
+package xtd
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// BytesOptions configures the output of FormatBytesWithOptions.
+type BytesOptions struct {
+	// SI selects decimal units (kB, MB, GB, ...; multiples of 1000)
+	// rather than the default binary IEC units (KiB, MiB, GiB, ...; multiples of 1024).
+	SI bool
+	// Precision is the maximum number of decimal places shown.
+	// Trailing zeros are always removed.
+	Precision int
+}
+
+var (
+	iecByteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	siByteUnits  = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+)
+
+// FormatBytes returns the passed number of bytes as a human-readable string
+// using binary IEC units with up to two decimal places (1073741824 -> "1 GiB",
+// 1536 -> "1.5 KiB").
+func FormatBytes[T constraints.Integer](n T) string {
+	return FormatBytesWithOptions(n, BytesOptions{Precision: 2})
+}
+
+// FormatBytesWithOptions is identical to FormatBytes, except that the unit system
+// and precision are configured by the passed BytesOptions.
+func FormatBytesWithOptions[T constraints.Integer](n T, opts BytesOptions) string {
+	base, units := 1024.0, iecByteUnits
+	if opts.SI {
+		base, units = 1000.0, siByteUnits
+	}
+
+	val := float64(n)
+
+	sign := ""
+	if val < 0 {
+		sign, val = "-", -val
+	}
+
+	precision := func(unit int) int {
+		if unit == 0 {
+			return 0
+		}
+
+		return maxOf(opts.Precision, 0)
+	}
+
+	// Move up a unit based on the value as it will be displayed,
+	// so that 1048575 bytes is "1 MiB" rather than "1024 KiB".
+	unit := 0
+	for unit < len(units)-1 && roundFloat(val, precision(unit)) >= base {
+		val /= base
+		unit++
+	}
+
+	formatted := strconv.FormatFloat(val, 'f', precision(unit), 64)
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+
+	return sign + formatted + " " + units[unit]
+}
+
+// roundFloat rounds the passed value to the passed number of decimal places,
+// exactly as strconv.FormatFloat would display it.
+func roundFloat(val float64, precision int) float64 {
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(val, 'f', precision, 64), 64)
+	return rounded
+}
+
+// byteMultipliers maps lowercase unit suffixes accepted by ParseBytes to their size in bytes.
+var byteMultipliers = map[string]uint64{
+	"": 1, "b": 1,
+	"k": 1 << 10, "kib": 1 << 10, "kb": 1e3,
+	"m": 1 << 20, "mib": 1 << 20, "mb": 1e6,
+	"g": 1 << 30, "gib": 1 << 30, "gb": 1e9,
+	"t": 1 << 40, "tib": 1 << 40, "tb": 1e12,
+	"p": 1 << 50, "pib": 1 << 50, "pb": 1e15,
+	"e": 1 << 60, "eib": 1 << 60, "eb": 1e18,
+}
+
+// ParseBytes parses a human-readable byte size, such as "512", "1.5 GiB", "10MB" or "4k",
+// returning it as any integer type based on the specified type constraint.
+// Units are case-insensitive: IEC units (KiB, MiB, ...) and single letters (K, M, ...)
+// are multiples of 1024, while SI units (kB, MB, ...) are multiples of 1000.
+// Fractional results are rounded down to a whole number of bytes.
+// Like IntFromString, errors are returned as a *strconv.NumError, wrapping strconv.ErrSyntax
+// for malformed input or strconv.ErrRange if the size does not fit in T.
+func ParseBytes[T constraints.Integer](s string) (res T, err error) {
+	numErr := func(e error) error {
+		return &strconv.NumError{Func: "ParseBytes", Num: s, Err: e}
+	}
+
+	trimmed := strings.TrimSpace(s)
+
+	split := strings.IndexFunc(trimmed, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r == '.')
+	})
+	if split == -1 {
+		split = len(trimmed)
+	}
+
+	numStr, unit := trimmed[:split], strings.ToLower(strings.TrimSpace(trimmed[split:]))
+
+	multiplier, ok := byteMultipliers[unit]
+	if !ok || numStr == "" {
+		return 0, numErr(strconv.ErrSyntax)
+	}
+
+	num, ok := new(big.Float).SetPrec(128).SetString(numStr)
+	if !ok {
+		return 0, numErr(strconv.ErrSyntax)
+	}
+
+	total, _ := num.Mul(num, new(big.Float).SetUint64(multiplier)).Int(nil)
+
+	if !total.IsUint64() || total.Uint64() > maxIntegerValue[T]() {
+		return 0, numErr(strconv.ErrRange)
+	}
+
+	return T(total.Uint64()), nil
+}
+
+// maxIntegerValue returns the maximum value representable by T, as a uint64.
+func maxIntegerValue[T constraints.Integer]() uint64 {
+	var zero T
+
+	ones := ^zero
+	if ones < 0 {
+		// Signed: all ones is -1, so the maximum is all ones but the sign bit.
+		return uint64(math.MaxUint64) >> (65 - bitSizeOf(zero))
+	}
+
+	return uint64(ones)
+}
+
+func bitSizeOf[T constraints.Integer](n T) int {
+	size := 0
+
+	for v := ^T(0); v != 0; v <<= 1 {
+		size++
+	}
+
+	return size
+}
+
+// DurationOptions configures the output of FormatDurationWithOptions.
+type DurationOptions struct {
+	// Verbose selects output such as "1 hour, 2 minutes" rather than "1h2m".
+	Verbose bool
+	// MaxUnits is the maximum number of units shown, with the duration rounded
+	// to the smallest of them. If less than 1, all non-zero units are shown.
+	MaxUnits int
+}
+
+type durationUnit struct {
+	d       time.Duration
+	compact string
+	verbose string
+}
+
+var durationUnits = []durationUnit{
+	{24 * time.Hour, "d", "day"},
+	{time.Hour, "h", "hour"},
+	{time.Minute, "m", "minute"},
+	{time.Second, "s", "second"},
+	{time.Millisecond, "ms", "millisecond"},
+	{time.Microsecond, "µs", "microsecond"},
+	{time.Nanosecond, "ns", "nanosecond"},
+}
+
+// FormatDuration returns the passed duration in a compact, human-readable form,
+// showing at most its two largest units and rounding to the smaller of them
+// (3723s -> "1h2m", 90061s -> "1d1h", 1500ms -> "1s500ms").
+func FormatDuration(d time.Duration) string {
+	return FormatDurationWithOptions(d, DurationOptions{MaxUnits: 2})
+}
+
+// FormatDurationWithOptions is identical to FormatDuration, except that
+// verbosity and the number of units shown are configured by the passed DurationOptions.
+// Units which are zero are omitted, and a zero duration is formatted as "0s" or "0 seconds".
+func FormatDurationWithOptions(d time.Duration, opts DurationOptions) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+
+		if d == math.MinInt64 {
+			d = math.MaxInt64
+		} else {
+			d = -d
+		}
+	}
+
+	first := 0
+	for first < len(durationUnits)-1 && d < durationUnits[first].d {
+		first++
+	}
+
+	if opts.MaxUnits > 0 && first+opts.MaxUnits < len(durationUnits) {
+		smallest := durationUnits[first+opts.MaxUnits-1].d
+
+		rounded := d.Round(smallest)
+		if rounded < 0 {
+			// Rounding overflowed; truncate instead.
+			rounded = d.Truncate(smallest)
+		}
+
+		if rounded >= durationUnits[maxOf(first-1, 0)].d && first > 0 {
+			// Rounding carried into a larger unit (59.9s -> 1m).
+			first--
+		}
+
+		d = rounded
+	}
+
+	var parts []string
+
+	for i := first; i < len(durationUnits) && d > 0; i++ {
+		if opts.MaxUnits > 0 && i >= first+opts.MaxUnits {
+			break
+		}
+
+		u := durationUnits[i]
+
+		n := d / u.d
+		d -= n * u.d
+
+		if n == 0 {
+			continue
+		}
+
+		parts = append(parts, formatDurationPart(int64(n), u, opts.Verbose))
+	}
+
+	if len(parts) == 0 {
+		return formatDurationPart(0, durationUnits[3], opts.Verbose)
+	}
+
+	if opts.Verbose {
+		return sign + strings.Join(parts, ", ")
+	}
+
+	return sign + strings.Join(parts, "")
+}
+
+func formatDurationPart(n int64, u durationUnit, verbose bool) string {
+	if !verbose {
+		return strconv.FormatInt(n, 10) + u.compact
+	}
+
+	return Quantity(n, u.verbose)
+}
@@ -0,0 +1,166 @@
+package xtd_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  int64
+		want string
+	}{
+		{"zero", 0, "0 B"},
+		{"bytes", 512, "512 B"},
+		{"kibibytes", 1536, "1.5 KiB"},
+		{"gibibyte", 1073741824, "1 GiB"},
+		{"rounded", 1234567, "1.18 MiB"},
+		{"negative", -2048, "-2 KiB"},
+		{"just under mebibyte", 1048575, "1 MiB"},
+		{"just under kibibyte", 1023, "1023 B"},
+		{"rounds below boundary", 1048064, "1023.5 KiB"},
+		{"negative just under mebibyte", -1048575, "-1 MiB"},
+		{"max", math.MaxInt64, "8 EiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.FormatBytes(tt.arg))
+		})
+	}
+}
+
+func TestFormatBytesWithOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  uint64
+		opts xtd.BytesOptions
+		want string
+	}{
+		{"si", 1500000, xtd.BytesOptions{SI: true, Precision: 2}, "1.5 MB"},
+		{"si gigabyte", 1073741824, xtd.BytesOptions{SI: true, Precision: 3}, "1.074 GB"},
+		{"zero precision", 1536, xtd.BytesOptions{}, "2 KiB"},
+		{"bytes ignore precision", 999, xtd.BytesOptions{SI: true, Precision: 2}, "999 B"},
+		{"just under megabyte", 999999, xtd.BytesOptions{SI: true, Precision: 1}, "1 MB"},
+		{"just under megabyte, more precision", 999999, xtd.BytesOptions{SI: true, Precision: 3}, "999.999 kB"},
+		{"below boundary at precision", 999949, xtd.BytesOptions{SI: true, Precision: 1}, "999.9 kB"},
+		{"zero precision boundary", 1023*1024 + 600, xtd.BytesOptions{}, "1 MiB"},
+		{"max uint64", math.MaxUint64, xtd.BytesOptions{Precision: 2}, "16 EiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.FormatBytesWithOptions(tt.arg, tt.opts))
+		})
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want uint64
+	}{
+		{"plain", "512", 512},
+		{"bytes", "512 B", 512},
+		{"iec", "1.5 GiB", 1610612736},
+		{"si", "10MB", 10000000},
+		{"single letter", "4k", 4096},
+		{"case insensitive", "2 gib", 2147483648},
+		{"fraction rounded down", "1.5 B", 1},
+		{"whitespace", "  3 KiB ", 3072},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.ParseBytes[uint64](tt.arg)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("typed", func(t *testing.T) {
+		got8, err := xtd.ParseBytes[uint8]("255")
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(255), got8)
+
+		_, err = xtd.ParseBytes[uint8]("1 KiB")
+		assert.ErrorIs(t, err, strconv.ErrRange)
+
+		got16, err := xtd.ParseBytes[int16]("31 KiB")
+		assert.NoError(t, err)
+		assert.Equal(t, int16(31744), got16)
+
+		_, err = xtd.ParseBytes[int16]("32 KiB")
+		assert.ErrorIs(t, err, strconv.ErrRange)
+
+		gotInt, err := xtd.ParseBytes[int]("7 EiB")
+		assert.NoError(t, err)
+		assert.Equal(t, 7<<60, gotInt)
+
+		_, err = xtd.ParseBytes[uint64]("16 EiB")
+		assert.ErrorIs(t, err, strconv.ErrRange)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, arg := range []string{"", "GiB", "-1 KiB", "1.2.3 MB", "12 parsecs"} {
+			_, err := xtd.ParseBytes[int64](arg)
+			assert.ErrorIs(t, err, strconv.ErrSyntax, "input %q", arg)
+
+			var numErr *strconv.NumError
+			assert.ErrorAs(t, err, &numErr)
+		}
+	})
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  time.Duration
+		want string
+	}{
+		{"zero", 0, "0s"},
+		{"seconds", 3723 * time.Second, "1h2m"},
+		{"rounds", time.Hour + 2*time.Minute + 31*time.Second, "1h3m"},
+		{"carry", 59*time.Minute + 59*time.Second + 900*time.Millisecond, "1h"},
+		{"days", 90061 * time.Second, "1d1h"},
+		{"milliseconds", 1500 * time.Millisecond, "1s500ms"},
+		{"skips zero units", 2*time.Hour + 5*time.Second, "2h"},
+		{"sub-microsecond", 750 * time.Nanosecond, "750ns"},
+		{"negative", -90 * time.Second, "-1m30s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.FormatDuration(tt.arg))
+		})
+	}
+}
+
+func TestFormatDurationWithOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  time.Duration
+		opts xtd.DurationOptions
+		want string
+	}{
+		{"verbose", 3723 * time.Second, xtd.DurationOptions{Verbose: true, MaxUnits: 2}, "1 hour, 2 minutes"},
+		{"verbose all units", 3723 * time.Second, xtd.DurationOptions{Verbose: true}, "1 hour, 2 minutes, 3 seconds"},
+		{"verbose zero", 0, xtd.DurationOptions{Verbose: true}, "0 seconds"},
+		{"single unit", 3723 * time.Second, xtd.DurationOptions{MaxUnits: 1}, "1h"},
+		{"all units", 90061*time.Second + 5*time.Millisecond, xtd.DurationOptions{}, "1d1h1m1s5ms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.FormatDurationWithOptions(tt.arg, tt.opts))
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package xtd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// MissingKeysError is returned by InterpolateStrict when the template
+// references keys which have neither a value nor a default.
+type MissingKeysError struct {
+	// Keys lists each missing key once, in the order they first appear in the template.
+	Keys []string
+}
+
+func (e *MissingKeysError) Error() string {
+	return "interpolate: missing keys: " + strings.Join(e.Keys, ", ")
+}
+
+// InterpolateSyntaxError is returned by Interpolate and InterpolateStrict
+// when a template is malformed, recording the byte offset in the template
+// at which the problem was found.
+type InterpolateSyntaxError struct {
+	Msg string
+	Pos int
+}
+
+func (e *InterpolateSyntaxError) Error() string {
+	return fmt.Sprintf("interpolate: %s at position %d", e.Msg, e.Pos)
+}
+
+// Interpolate replaces placeholders in the passed template with values from vars,
+// which may be a string-keyed map, a struct or a pointer to a struct.
+// Struct fields are looked up by name, or by the name given in an
+// `interpolate:"name"` tag.
+//
+// Placeholders take the forms:
+//
+//	{name}               the value of name, formatted with fmt.Sprint
+//	{name:spec}          the value of name, formatted according to spec
+//	{name|default}       the value of name, or default if name is missing
+//	{name:spec|default}  both of the above
+//	{{ and }}            a literal '{' or '}'
+//
+// For time.Time values, spec is a time layout ("{date:2006-01-02}").
+// For everything else, spec is a fmt verb, with or without its leading '%'
+// ("{price:.2f}", "{id:%05d}").
+//
+// Placeholders for missing keys without a default are left in the output as-is;
+// use InterpolateStrict to treat them as an error instead.
+// A *InterpolateSyntaxError is returned if a placeholder is not terminated.
+func Interpolate(template string, vars any) (string, error) {
+	res, _, err := interpolate(template, vars)
+
+	return res, err
+}
+
+// InterpolateStrict is identical to Interpolate, except that if any placeholders
+// reference missing keys without a default, an empty string and a *MissingKeysError
+// listing every such key are returned.
+func InterpolateStrict(template string, vars any) (string, error) {
+	res, missing, err := interpolate(template, vars)
+	if err != nil {
+		return "", err
+	}
+
+	if len(missing) > 0 {
+		return "", &MissingKeysError{missing}
+	}
+
+	return res, nil
+}
+
+func interpolate(template string, vars any) (res string, missing []string, err error) {
+	lookup := interpolateLookup(vars)
+
+	var (
+		sb   strings.Builder
+		seen = make(map[string]bool)
+	)
+
+	sb.Grow(len(template))
+
+	for i := 0; i < len(template); i++ {
+		c := template[i]
+
+		switch {
+		case c == '{' && strings.HasPrefix(template[i:], "{{"),
+			c == '}' && strings.HasPrefix(template[i:], "}}"):
+			sb.WriteByte(c)
+			i++
+
+			continue
+		case c != '{':
+			sb.WriteByte(c)
+			continue
+		}
+
+		end := strings.IndexByte(template[i:], '}')
+		if end == -1 {
+			return "", nil, &InterpolateSyntaxError{"unterminated placeholder", i}
+		}
+
+		placeholder := template[i : i+end+1]
+		body := placeholder[1 : len(placeholder)-1]
+
+		body, def, hasDefault := strings.Cut(body, "|")
+		name, spec, _ := strings.Cut(body, ":")
+		name = strings.TrimSpace(name)
+
+		if name == "" {
+			return "", nil, &InterpolateSyntaxError{"empty placeholder name", i}
+		}
+
+		i += end
+
+		val, ok := lookup(name)
+
+		switch {
+		case ok:
+			sb.WriteString(formatInterpolated(val, spec))
+		case hasDefault:
+			sb.WriteString(def)
+		default:
+			sb.WriteString(placeholder)
+
+			if !seen[name] {
+				seen[name] = true
+				missing = append(missing, name)
+			}
+		}
+	}
+
+	return sb.String(), missing, nil
+}
+
+func interpolateLookup(vars any) func(string) (any, bool) {
+	rv := reflect.ValueOf(vars)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		return func(name string) (any, bool) {
+			v := rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+			if !v.IsValid() {
+				return nil, false
+			}
+
+			return v.Interface(), true
+		}
+	case reflect.Struct:
+		return func(name string) (any, bool) {
+			rt := rv.Type()
+
+			for i := 0; i < rt.NumField(); i++ {
+				sf := rt.Field(i)
+				if !sf.IsExported() {
+					continue
+				}
+
+				if tag, ok := sf.Tag.Lookup("interpolate"); (ok && tag == name) || (!ok && sf.Name == name) {
+					return rv.Field(i).Interface(), true
+				}
+			}
+
+			return nil, false
+		}
+	}
+
+	return func(string) (any, bool) {
+		return nil, false
+	}
+}
+
+func formatInterpolated(val any, spec string) string {
+	if spec == "" {
+		return fmt.Sprint(val)
+	}
+
+	switch v := val.(type) {
+	case time.Time:
+		return v.Format(spec)
+	case *time.Time:
+		if v != nil {
+			return v.Format(spec)
+		}
+	}
+
+	if !strings.HasPrefix(spec, "%") {
+		spec = "%" + spec
+	}
+
+	return fmt.Sprintf(spec, val)
+}
@@ -0,0 +1,99 @@
+package xtd_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+type interpolateTestStruct struct {
+	Tenant string
+	Date   time.Time `interpolate:"date"`
+	Count  int
+	hidden string
+}
+
+func TestInterpolate(t *testing.T) {
+	date := time.Date(2024, time.March, 9, 15, 4, 5, 0, time.UTC)
+
+	vars := map[string]any{
+		"tenant": "acme",
+		"date":   date,
+		"price":  4.5,
+		"id":     42,
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		vars     any
+		want     string
+	}{
+		{"plain", "no placeholders", vars, "no placeholders"},
+		{"simple", "/data/{tenant}/{id}", vars, "/data/acme/42"},
+		{"time layout", "/data/{tenant}/{date:2006-01-02}", vars, "/data/acme/2024-03-09"},
+		{"time layout with colons", "{date:15:04}", vars, "15:04"},
+		{"fmt verb", "{price:.2f} {id:%05d}", vars, "4.50 00042"},
+		{"default used", "{user|unknown}", vars, "unknown"},
+		{"default unused", "{tenant|unknown}", vars, "acme"},
+		{"empty default", "[{user|}]", vars, "[]"},
+		{"missing left as-is", "{user}/{tenant}", vars, "{user}/acme"},
+		{"escaped braces", "{{tenant}} {tenant} }}", vars, "{tenant} acme }"},
+		{"string map", "{a}-{b}", map[string]string{"a": "x", "b": "y"}, "x-y"},
+		{"struct", "{Tenant}/{date:2006}/{Count}", interpolateTestStruct{Tenant: "acme", Date: date, Count: 3, hidden: "x"}, "acme/2024/3"},
+		{"struct pointer", "{Tenant}", &interpolateTestStruct{Tenant: "acme"}, "acme"},
+		{"struct unexported", "{hidden}", interpolateTestStruct{hidden: "x"}, "{hidden}"},
+		{"nil vars", "{a|b}", nil, "b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.Interpolate(tt.template, tt.vars)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInterpolate_errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantPos  int
+	}{
+		{"unterminated", "/data/{tenant", 6},
+		{"empty name", "/data/{}", 6},
+		{"empty name with default", "/data/{|x}", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := xtd.Interpolate(tt.template, nil)
+
+			var syntaxErr *xtd.InterpolateSyntaxError
+			if assert.ErrorAs(t, err, &syntaxErr) {
+				assert.Equal(t, tt.wantPos, syntaxErr.Pos)
+			}
+		})
+	}
+}
+
+func TestInterpolateStrict(t *testing.T) {
+	vars := map[string]string{"tenant": "acme"}
+
+	got, err := xtd.InterpolateStrict("/data/{tenant}/{region|us}", vars)
+	assert.NoError(t, err)
+	assert.Equal(t, "/data/acme/us", got)
+
+	got, err = xtd.InterpolateStrict("/{user}/{tenant}/{date}/{user}", vars)
+	assert.Empty(t, got)
+
+	var missingErr *xtd.MissingKeysError
+	if assert.ErrorAs(t, err, &missingErr) {
+		assert.Equal(t, []string{"user", "date"}, missingErr.Keys)
+		assert.EqualError(t, err, "interpolate: missing keys: user, date")
+	}
+}
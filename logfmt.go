@@ -0,0 +1,331 @@
+package xtd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidLogfmtKey is returned when encoding a logfmt key
+// which is empty or contains spaces, '=', '"' or control characters.
+var ErrInvalidLogfmtKey = errors.New("invalid logfmt key")
+
+// LogfmtField is a single key/value pair of a logfmt record.
+type LogfmtField struct {
+	Key   string
+	Value string
+}
+
+// LogfmtSyntaxError is returned when decoding malformed logfmt,
+// recording the byte offset in the record at which decoding failed.
+type LogfmtSyntaxError struct {
+	Msg string
+	Pos int
+}
+
+func (e *LogfmtSyntaxError) Error() string {
+	return fmt.Sprintf("logfmt: %s at position %d", e.Msg, e.Pos)
+}
+
+// DecodeLogfmt decodes a single logfmt record, such as `team=core tier=1 msg="hello world"`,
+// into its key/value pairs, in the order they appear.
+// Keys without a value (`debug`) or with an empty value (`debug=`) decode to an empty Value.
+// Quoted values may contain Go-style escape sequences.
+func DecodeLogfmt(s string) (fields []LogfmtField, err error) {
+	fields = []LogfmtField{}
+
+	for i := 0; i < len(s); {
+		if isLogfmtSpace(s[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(s) && isLogfmtKeyByte(s[i]) {
+			i++
+		}
+
+		if i == start {
+			return nil, &LogfmtSyntaxError{fmt.Sprintf("unexpected %q", s[i]), i}
+		}
+
+		field := LogfmtField{Key: s[start:i]}
+
+		if i < len(s) && s[i] == '=' {
+			i++
+
+			field.Value, i, err = decodeLogfmtValue(s, i)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if i < len(s) && !isLogfmtSpace(s[i]) {
+			return nil, &LogfmtSyntaxError{fmt.Sprintf("unexpected %q", s[i]), i}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return
+}
+
+func decodeLogfmtValue(s string, i int) (val string, next int, err error) {
+	if i == len(s) || s[i] != '"' {
+		start := i
+		for i < len(s) && !isLogfmtSpace(s[i]) {
+			if s[i] == '"' || s[i] == '=' {
+				return "", i, &LogfmtSyntaxError{fmt.Sprintf("unexpected %q in unquoted value", s[i]), i}
+			}
+
+			i++
+		}
+
+		return s[start:i], i, nil
+	}
+
+	start := i
+	for i++; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			val, err = strconv.Unquote(s[start : i+1])
+			if err != nil {
+				return "", start, &LogfmtSyntaxError{"invalid quoted value", start}
+			}
+
+			return val, i + 1, nil
+		}
+	}
+
+	return "", start, &LogfmtSyntaxError{"unterminated quoted value", start}
+}
+
+// DecodeLogfmtMap decodes a single logfmt record into a map of keys to values.
+// If a key appears more than once, its last value wins.
+func DecodeLogfmtMap(s string) (map[string]string, error) {
+	fields, err := DecodeLogfmt(s)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string, len(fields))
+	for _, f := range fields {
+		m[f.Key] = f.Value
+	}
+
+	return m, nil
+}
+
+// EncodeLogfmt encodes the passed key/value pairs as a single logfmt record, in order.
+// Values which are empty or contain spaces, '=', '"', control characters
+// or invalid UTF-8 are quoted.
+// If any key is not a valid logfmt key, ErrInvalidLogfmtKey is returned.
+func EncodeLogfmt(fields []LogfmtField) (string, error) {
+	var sb strings.Builder
+
+	for i, f := range fields {
+		if !isValidLogfmtKey(f.Key) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidLogfmtKey, f.Key)
+		}
+
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString(f.Key)
+		sb.WriteByte('=')
+		sb.WriteString(quoteLogfmtValue(f.Value))
+	}
+
+	return sb.String(), nil
+}
+
+// EncodeLogfmtMap encodes the passed map as a single logfmt record,
+// with keys sorted so that output is deterministic.
+func EncodeLogfmtMap(m map[string]string) (string, error) {
+	fields := make([]LogfmtField, 0, len(m))
+	for k, v := range m {
+		fields = append(fields, LogfmtField{k, v})
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Key < fields[j].Key
+	})
+
+	return EncodeLogfmt(fields)
+}
+
+// MarshalLogfmt encodes the passed struct, pointer to struct or
+// string-keyed map as a single logfmt record.
+// Values are formatted with fmt.Sprint; map keys are sorted.
+// Exported struct fields are encoded in declaration order, using the
+// name given in a `logfmt:"name"` tag if present, or the field name otherwise.
+// Fields tagged `logfmt:"-"` are skipped, as are nil pointer fields.
+func MarshalLogfmt(v any) (string, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		return EncodeLogfmt(structLogfmtFields(rv))
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		m := make(map[string]string, rv.Len())
+
+		iter := rv.MapRange()
+		for iter.Next() {
+			m[iter.Key().String()] = fmt.Sprint(iter.Value().Interface())
+		}
+
+		return EncodeLogfmtMap(m)
+	}
+
+	return "", fmt.Errorf("logfmt: cannot marshal %T", v)
+}
+
+func structLogfmtFields(rv reflect.Value) []LogfmtField {
+	rt := rv.Type()
+	fields := make([]LogfmtField, 0, rt.NumField())
+
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		key := sf.Name
+		if tag, ok := sf.Tag.Lookup("logfmt"); ok {
+			if tag == "-" {
+				continue
+			} else if tag != "" {
+				key = tag
+			}
+		}
+
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+
+			fv = fv.Elem()
+		}
+
+		fields = append(fields, LogfmtField{key, fmt.Sprint(fv.Interface())})
+	}
+
+	return fields
+}
+
+// LogfmtDecoder reads logfmt records, one per line, from an io.Reader.
+// Blank lines are skipped, and lines may be of any length.
+type LogfmtDecoder struct {
+	reader *bufio.Reader
+	fields []LogfmtField
+	line   int
+	err    error
+}
+
+// NewLogfmtDecoder constructs a LogfmtDecoder reading from the passed io.Reader.
+func NewLogfmtDecoder(r io.Reader) *LogfmtDecoder {
+	return &LogfmtDecoder{
+		reader: bufio.NewReader(r),
+	}
+}
+
+// Next decodes the next record, returning false once the input
+// is exhausted or an error occurs, after which Err should be checked.
+func (d *LogfmtDecoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+
+	for {
+		line, err := d.reader.ReadString('\n')
+		if line == "" && err != nil {
+			if err != io.EOF {
+				d.err = err
+			}
+
+			break
+		}
+
+		d.line++
+
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		d.fields, d.err = DecodeLogfmt(line)
+		if d.err != nil {
+			d.err = fmt.Errorf("line %d: %w", d.line, d.err)
+			return false
+		}
+
+		return true
+	}
+
+	d.fields = nil
+
+	return false
+}
+
+// Fields returns the key/value pairs of the record decoded by the last call to Next.
+func (d *LogfmtDecoder) Fields() []LogfmtField {
+	return d.fields
+}
+
+// Err returns the first error encountered by the LogfmtDecoder, if any.
+func (d *LogfmtDecoder) Err() error {
+	return d.err
+}
+
+func quoteLogfmtValue(val string) string {
+	if val == "" || !utf8.ValidString(val) {
+		return strconv.Quote(val)
+	}
+
+	for i := 0; i < len(val); i++ {
+		if c := val[i]; c <= ' ' || c == '=' || c == '"' || c == 0x7f {
+			return strconv.Quote(val)
+		}
+	}
+
+	return val
+}
+
+func isValidLogfmtKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for i := 0; i < len(key); i++ {
+		if !isLogfmtKeyByte(key[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLogfmtKeyByte(c byte) bool {
+	return c > ' ' && c != '=' && c != '"' && c != 0x7f
+}
+
+func isLogfmtSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
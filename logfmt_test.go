@@ -0,0 +1,184 @@
+package xtd_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/xtd"
+)
+
+type logfmtTestStruct struct {
+	Team     string `logfmt:"team"`
+	Tier     int    `logfmt:"tier"`
+	Message  string `logfmt:"msg"`
+	Secret   string `logfmt:"-"`
+	Optional *int
+	internal string
+}
+
+func TestDecodeLogfmt(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want []xtd.LogfmtField
+	}{
+		{"empty", "", []xtd.LogfmtField{}},
+		{"simple", "team=core tier=1", []xtd.LogfmtField{{"team", "core"}, {"tier", "1"}}},
+		{"quoted", `msg="hello world" eq="a=b"`, []xtd.LogfmtField{{"msg", "hello world"}, {"eq", "a=b"}}},
+		{"escapes", `msg="say \"hi\"\n\ttab"`, []xtd.LogfmtField{{"msg", "say \"hi\"\n\ttab"}}},
+		{"bare key", "debug level=", []xtd.LogfmtField{{"debug", ""}, {"level", ""}}},
+		{"extra whitespace", "  a=1 \t b=2  ", []xtd.LogfmtField{{"a", "1"}, {"b", "2"}}},
+		{"unicode", "名前=値", []xtd.LogfmtField{{"名前", "値"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.DecodeLogfmt(tt.arg)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeLogfmt_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		wantPos int
+	}{
+		{"unterminated", `a=1 msg="hello`, 8},
+		{"missing key", "=1", 0},
+		{"quote in value", `a=b"c`, 3},
+		{"trailing quote", `a="b"c`, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.DecodeLogfmt(tt.arg)
+			assert.Nil(t, got)
+
+			var syntaxErr *xtd.LogfmtSyntaxError
+			if assert.ErrorAs(t, err, &syntaxErr) {
+				assert.Equal(t, tt.wantPos, syntaxErr.Pos)
+			}
+		})
+	}
+}
+
+func TestDecodeLogfmtMap(t *testing.T) {
+	got, err := xtd.DecodeLogfmtMap("team=core tier=1 tier=2")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "core", "tier": "2"}, got)
+}
+
+func TestEncodeLogfmt(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     []xtd.LogfmtField
+		want    string
+		wantErr bool
+	}{
+		{"simple", []xtd.LogfmtField{{"team", "core"}, {"tier", "1"}}, "team=core tier=1", false},
+		{"quoting", []xtd.LogfmtField{{"msg", "hello world"}, {"eq", "a=b"}, {"empty", ""}}, `msg="hello world" eq="a=b" empty=""`, false},
+		{"control", []xtd.LogfmtField{{"msg", "a\nb"}}, `msg="a\nb"`, false},
+		{"invalid key", []xtd.LogfmtField{{"bad key", "x"}}, "", true},
+		{"empty key", []xtd.LogfmtField{{"", "x"}}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.EncodeLogfmt(tt.arg)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, xtd.ErrInvalidLogfmtKey)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			decoded, err := xtd.DecodeLogfmt(got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.arg, decoded)
+		})
+	}
+}
+
+func TestEncodeLogfmtMap(t *testing.T) {
+	got, err := xtd.EncodeLogfmtMap(map[string]string{"tier": "1", "team": "core"})
+	assert.NoError(t, err)
+	assert.Equal(t, "team=core tier=1", got)
+}
+
+func TestMarshalLogfmt(t *testing.T) {
+	optional := 7
+
+	tests := []struct {
+		name    string
+		arg     any
+		want    string
+		wantErr bool
+	}{
+		{"struct", logfmtTestStruct{Team: "core", Tier: 1, Message: "hi there", Secret: "x", internal: "x"}, `team=core tier=1 msg="hi there"`, false},
+		{"pointer", &logfmtTestStruct{Team: "core", Optional: &optional}, `team=core tier=0 msg="" Optional=7`, false},
+		{"map", map[string]any{"b": 2, "a": true}, "a=true b=2", false},
+		{"unsupported", 42, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.MarshalLogfmt(tt.arg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLogfmtDecoder(t *testing.T) {
+	input := "a=1 b=2\n\nc=\"three\"\n"
+
+	dec := xtd.NewLogfmtDecoder(strings.NewReader(input))
+
+	var records [][]xtd.LogfmtField
+	for dec.Next() {
+		records = append(records, dec.Fields())
+	}
+
+	assert.NoError(t, dec.Err())
+	assert.Equal(t, [][]xtd.LogfmtField{
+		{{"a", "1"}, {"b", "2"}},
+		{{"c", "three"}},
+	}, records)
+
+	t.Run("error", func(t *testing.T) {
+		dec := xtd.NewLogfmtDecoder(strings.NewReader("a=1\nb=\"2\n"))
+
+		assert.True(t, dec.Next())
+		assert.False(t, dec.Next())
+		assert.ErrorContains(t, dec.Err(), "line 2")
+	})
+
+	t.Run("long lines", func(t *testing.T) {
+		trace := strings.Repeat("goroutine 1 [running]:\n\tmain.main()\n", 10_000)
+		input := "level=error msg=" + strconv.Quote(trace) + "\r\nlevel=info msg=ok"
+
+		dec := xtd.NewLogfmtDecoder(strings.NewReader(input))
+
+		require.True(t, dec.Next())
+		assert.Equal(t, []xtd.LogfmtField{{"level", "error"}, {"msg", trace}}, dec.Fields())
+
+		require.True(t, dec.Next(), "last line without a trailing newline")
+		assert.Equal(t, []xtd.LogfmtField{{"level", "info"}, {"msg", "ok"}}, dec.Fields())
+
+		assert.False(t, dec.Next())
+		assert.NoError(t, dec.Err())
+	})
+}
@@ -0,0 +1,141 @@
+package xtd
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// NaturalLess reports whether a sorts before b in "natural" order,
+// in which runs of ASCII digits are compared by their numeric value
+// rather than rune-by-rune, so that "shard2" sorts before "shard10".
+// Digit runs of any length are supported without overflow.
+// Numerically equal runs with differing leading zeros ("01" and "1")
+// sort with the shorter run first, but only if the strings are otherwise equal.
+func NaturalLess(a, b string) bool {
+	return naturalCompare(a, b, false) < 0
+}
+
+// NaturalLessFold is identical to NaturalLess, except that
+// non-digit runes are compared case-insensitively.
+// Strings differing only in case sort in NaturalLess order.
+func NaturalLessFold(a, b string) bool {
+	return naturalCompare(a, b, true) < 0
+}
+
+// SortNatural returns a newly allocated slice containing the entries of
+// the passed slice sorted in NaturalLess order.
+// The sort is stable, so equal entries keep their ordering
+// respective to the passed slice, which is left unmodified.
+func SortNatural(data []string) []string {
+	return sortNatural(data, NaturalLess)
+}
+
+// SortNaturalFold is identical to SortNatural,
+// except that entries are sorted in NaturalLessFold order.
+func SortNaturalFold(data []string) []string {
+	return sortNatural(data, NaturalLessFold)
+}
+
+func sortNatural(data []string, less func(a, b string) bool) []string {
+	res := make([]string, len(data))
+	copy(res, data)
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+
+	return res
+}
+
+func naturalCompare(a, b string, fold bool) int {
+	var (
+		i, j     int
+		tiebreak int
+	)
+
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			aStart, bStart := i, j
+
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+
+			aRun, bRun := trimLeadingZeros(a[aStart:i]), trimLeadingZeros(b[bStart:j])
+
+			switch {
+			case len(aRun) != len(bRun):
+				return compareOf(len(aRun), len(bRun))
+			case aRun != bRun:
+				return compareOf(aRun, bRun)
+			case tiebreak == 0:
+				tiebreak = compareOf(i-aStart, j-bStart)
+			}
+
+			continue
+		}
+
+		ra, sizeA := utf8.DecodeRuneInString(a[i:])
+		rb, sizeB := utf8.DecodeRuneInString(b[j:])
+
+		i += sizeA
+		j += sizeB
+
+		if fold {
+			if la, lb := unicode.ToLower(ra), unicode.ToLower(rb); la != lb {
+				return compareOf(la, lb)
+			}
+		}
+
+		if ra != rb {
+			if fold {
+				if tiebreak == 0 {
+					tiebreak = compareOf(ra, rb)
+				}
+
+				continue
+			}
+
+			return compareOf(ra, rb)
+		}
+	}
+
+	switch {
+	case i < len(a):
+		return 1
+	case j < len(b):
+		return -1
+	}
+
+	return tiebreak
+}
+
+func trimLeadingZeros(s string) string {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+
+	return s
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func compareOf[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
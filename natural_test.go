@@ -0,0 +1,64 @@
+package xtd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestNaturalLess(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"equal", "shard1", "shard1", false},
+		{"numeric", "shard2", "shard10", true},
+		{"numeric reversed", "shard10", "shard2", false},
+		{"prefix", "shard", "shard1", true},
+		{"leading zeros", "shard01", "shard1", false},
+		{"leading zeros tiebreak", "shard1", "shard01", true},
+		{"leading zeros value", "shard002", "shard10", true},
+		{"long digit runs", "x123456789012345678901234567890", "x123456789012345678901234567891", true},
+		{"long digit runs length", "x99999999999999999999", "x100000000000000000000", true},
+		{"multiple runs", "v1.10.2", "v1.9.10", false},
+		{"case sensitive", "B", "a", true},
+		{"unicode", "é2", "é10", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.NaturalLess(tt.a, tt.b))
+		})
+	}
+}
+
+func TestNaturalLessFold(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"case insensitive", "a", "B", true},
+		{"case insensitive reversed", "B", "a", false},
+		{"case only tiebreak", "File", "file", true},
+		{"case only tiebreak reversed", "file", "File", false},
+		{"numeric", "Shard2", "shard10", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.NaturalLessFold(tt.a, tt.b))
+		})
+	}
+}
+
+func TestSortNatural(t *testing.T) {
+	args := []string{"shard10", "shard2", "shard1", "Shard3", "shard01"}
+
+	assert.Equal(t, []string{"Shard3", "shard1", "shard01", "shard2", "shard10"}, xtd.SortNatural(args))
+	assert.Equal(t, []string{"shard1", "shard01", "shard2", "Shard3", "shard10"}, xtd.SortNaturalFold(args))
+	assert.Equal(t, []string{"shard10", "shard2", "shard1", "Shard3", "shard01"}, args, "input must be left unmodified")
+}
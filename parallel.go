@@ -0,0 +1,120 @@
+package xtd
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMapSlice is identical to MapSlice, except that the passed UnaryFn
+// is called on up to workers entries concurrently.
+// If workers is less than 1, runtime.GOMAXPROCS(0) is used.
+// The returned slice is in the same order as the passed slice.
+func ParallelMapSlice[T, U any](data []T, workers int, fn UnaryFn[T, U]) (res []U) {
+	res, _ = parallelMapSlice(context.Background(), data, workers, func(_ context.Context, d T) (U, error) {
+		return fn(d), nil
+	})
+
+	return
+}
+
+// ParallelMapSliceError is identical to MapSliceError, except that the passed UnaryErrFn
+// is called on up to workers entries concurrently.
+// If workers is less than 1, runtime.GOMAXPROCS(0) is used.
+// Once any call returns an error, no further entries are started,
+// and a nil slice and the first error returned are returned once in-flight calls finish.
+// Otherwise, the returned slice is in the same order as the passed slice.
+func ParallelMapSliceError[T, U any](data []T, workers int, fn UnaryErrFn[T, U]) (res []U, err error) {
+	return parallelMapSlice(context.Background(), data, workers, func(_ context.Context, d T) (U, error) {
+		return fn(d)
+	})
+}
+
+// ParallelMapSliceContext is identical to MapSliceContext, except that the passed UnaryCtxFn
+// is called on up to workers entries concurrently.
+// If workers is less than 1, runtime.GOMAXPROCS(0) is used.
+// If the passed context is done before all entries are started, no further entries are started,
+// and a nil slice and an *IndexError wrapping ctx.Err() are returned once in-flight calls finish,
+// recording the first entry which was not started.
+// Otherwise, the returned slice is in the same order as the passed slice.
+func ParallelMapSliceContext[T, U any](ctx context.Context, data []T, workers int, fn UnaryCtxFn[T, U]) (res []U, err error) {
+	return parallelMapSlice(ctx, data, workers, fn.ErrFn())
+}
+
+// ParallelMapSliceContextError is identical to MapSliceContextError, except that the passed UnaryCtxErrFn
+// is called on up to workers entries concurrently.
+// If workers is less than 1, runtime.GOMAXPROCS(0) is used.
+// The function is passed a context derived from ctx, which is cancelled as soon as any call
+// returns an error; no further entries are then started, and a nil slice and the first error
+// returned are returned once in-flight calls finish. If ctx itself is done first,
+// an *IndexError wrapping ctx.Err() is returned, as with ParallelMapSliceContext.
+// Otherwise, the returned slice is in the same order as the passed slice.
+//
+// All goroutines started have exited by the time this function returns,
+// provided the passed function returns promptly once its context is done.
+func ParallelMapSliceContextError[T, U any](ctx context.Context, data []T, workers int, fn UnaryCtxErrFn[T, U]) (res []U, err error) {
+	return parallelMapSlice(ctx, data, workers, fn)
+}
+
+func parallelMapSlice[T, U any](ctx context.Context, data []T, workers int, fn UnaryCtxErrFn[T, U]) ([]U, error) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	workers = minOf(workers, len(data))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		res            = make([]U, len(data))
+		next     int64 = -1
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	setErr := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	wg.Add(workers)
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(data) {
+					return
+				}
+
+				if err := ctx.Err(); err != nil {
+					setErr(&IndexError{Index: i, Err: err})
+					return
+				}
+
+				fnRes, err := fn(ctx, data[i])
+				if err != nil {
+					setErr(err)
+					return
+				}
+
+				res[i] = fnRes
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return res, nil
+}
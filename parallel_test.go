@@ -0,0 +1,185 @@
+package xtd_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/xtd"
+)
+
+func parallelTestData(n int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = i
+	}
+
+	return data
+}
+
+func TestParallelMapSlice(t *testing.T) {
+	data := parallelTestData(100)
+
+	for _, workers := range []int{-1, 0, 1, 4, 1000} {
+		t.Run(strconv.Itoa(workers)+" workers", func(t *testing.T) {
+			got := xtd.ParallelMapSlice(data, workers, strconv.Itoa)
+			assert.Equal(t, xtd.MapSlice(data, strconv.Itoa), got)
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, xtd.ParallelMapSlice(nil, 4, strconv.Itoa))
+	})
+}
+
+func TestParallelMapSlice_workerLimit(t *testing.T) {
+	const workers = 3
+
+	var active, peak int32
+
+	xtd.ParallelMapSlice(parallelTestData(30), workers, func(n int) int {
+		cur := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+
+		for {
+			old := atomic.LoadInt32(&peak)
+			if cur <= old || atomic.CompareAndSwapInt32(&peak, old, cur) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+
+		return n
+	})
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(workers))
+}
+
+func TestParallelMapSliceError(t *testing.T) {
+	errOdd := errors.New("odd")
+
+	t.Run("success", func(t *testing.T) {
+		got, err := xtd.ParallelMapSliceError([]string{"1", "2", "3"}, 2, strconv.Atoi)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var calls int32
+
+		got, err := xtd.ParallelMapSliceError(parallelTestData(1000), 1, func(n int) (int, error) {
+			atomic.AddInt32(&calls, 1)
+
+			if n == 5 {
+				return 0, errOdd
+			}
+
+			return n, nil
+		})
+
+		assert.ErrorIs(t, err, errOdd)
+		assert.Nil(t, got)
+		assert.Equal(t, int32(6), atomic.LoadInt32(&calls))
+	})
+}
+
+func TestParallelMapSliceContext(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		got, err := xtd.ParallelMapSliceContext(context.Background(), []int{1, 2, 3}, 2, func(_ context.Context, n int) int {
+			return n * 2
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, got)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		got, err := xtd.ParallelMapSliceContext(ctx, []int{1, 2, 3}, 2, func(_ context.Context, n int) int {
+			return n
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, got)
+
+		var idxErr *xtd.IndexError
+		require.ErrorAs(t, err, &idxErr)
+		assert.Equal(t, 0, idxErr.Index)
+	})
+}
+
+func TestParallelMapSliceContextError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	t.Run("first error cancels siblings", func(t *testing.T) {
+		const workers = 8
+
+		var (
+			cancelled int32
+			started   = make(chan struct{}, workers-1)
+		)
+
+		got, err := xtd.ParallelMapSliceContextError(context.Background(), parallelTestData(workers), workers, func(ctx context.Context, n int) (int, error) {
+			if n == 0 {
+				for i := 0; i < workers-1; i++ {
+					<-started
+				}
+
+				return 0, errBoom
+			}
+
+			started <- struct{}{}
+
+			select {
+			case <-ctx.Done():
+				atomic.AddInt32(&cancelled, 1)
+				return 0, ctx.Err()
+			case <-time.After(5 * time.Second):
+				return n, nil
+			}
+		})
+
+		assert.ErrorIs(t, err, errBoom)
+		assert.Nil(t, got)
+		assert.Equal(t, int32(workers-1), atomic.LoadInt32(&cancelled))
+	})
+
+	t.Run("parent cancelled", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		started := make(chan struct{}, 4)
+
+		go func() {
+			<-started
+			cancel()
+		}()
+
+		got, err := xtd.ParallelMapSliceContextError(ctx, parallelTestData(100), 4, func(ctx context.Context, n int) (int, error) {
+			started <- struct{}{}
+			<-ctx.Done()
+
+			return 0, ctx.Err()
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, got)
+
+		for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		assert.LessOrEqual(t, runtime.NumGoroutine(), before, "worker goroutines leaked")
+	})
+}
@@ -0,0 +1,250 @@
+package xtd
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// pluralExceptions holds the irregular singular -> plural mappings
+// and uncountable nouns used by Pluralize and Singularize.
+type pluralExceptions struct {
+	mu          sync.RWMutex
+	plurals     map[string]string
+	singulars   map[string]string
+	uncountable map[string]bool
+}
+
+var defaultPluralExceptions = newPluralExceptions()
+
+func newPluralExceptions() *pluralExceptions {
+	e := &pluralExceptions{
+		plurals:     make(map[string]string),
+		singulars:   make(map[string]string),
+		uncountable: make(map[string]bool),
+	}
+
+	irregulars := [][2]string{
+		{"person", "people"}, {"man", "men"}, {"woman", "women"}, {"child", "children"},
+		{"tooth", "teeth"}, {"foot", "feet"}, {"goose", "geese"}, {"mouse", "mice"},
+		{"ox", "oxen"}, {"die", "dice"}, {"quiz", "quizzes"},
+		{"knife", "knives"}, {"wife", "wives"}, {"life", "lives"}, {"leaf", "leaves"},
+		{"wolf", "wolves"}, {"half", "halves"}, {"shelf", "shelves"}, {"thief", "thieves"},
+		{"calf", "calves"}, {"loaf", "loaves"}, {"self", "selves"}, {"elf", "elves"},
+		{"hero", "heroes"}, {"potato", "potatoes"}, {"tomato", "tomatoes"},
+		{"echo", "echoes"}, {"veto", "vetoes"}, {"torpedo", "torpedoes"},
+		{"analysis", "analyses"}, {"crisis", "crises"}, {"thesis", "theses"}, {"axe", "axes"},
+		{"index", "indices"}, {"matrix", "matrices"},
+		{"vertex", "vertices"}, {"appendix", "appendices"}, {"criterion", "criteria"},
+		{"phenomenon", "phenomena"}, {"datum", "data"}, {"medium", "media"},
+		{"cactus", "cacti"}, {"fungus", "fungi"}, {"radius", "radii"}, {"alumnus", "alumni"},
+		{"status", "statuses"}, {"bus", "buses"}, {"virus", "viruses"}, {"campus", "campuses"},
+	}
+
+	for _, pair := range irregulars {
+		e.plurals[pair[0]] = pair[1]
+		e.singulars[pair[1]] = pair[0]
+	}
+
+	for _, word := range []string{
+		"equipment", "information", "rice", "money", "species", "series", "fish",
+		"sheep", "deer", "news", "metadata", "software", "hardware", "feedback", "aircraft",
+	} {
+		e.uncountable[word] = true
+	}
+
+	return e
+}
+
+// RegisterPlural adds (or overrides) an irregular plural form used by
+// Pluralize and Singularize, which will map singular to plural and back.
+// It is safe to call concurrently with other functions in this file.
+func RegisterPlural(singular, plural string) {
+	e := defaultPluralExceptions
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+
+	if old, ok := e.plurals[singular]; ok {
+		delete(e.singulars, old)
+	}
+
+	e.plurals[singular] = plural
+	e.singulars[plural] = singular
+	delete(e.uncountable, singular)
+	delete(e.uncountable, plural)
+}
+
+// RegisterUncountable marks the passed word as uncountable,
+// such that Pluralize and Singularize return it unchanged.
+// It is safe to call concurrently with other functions in this file.
+func RegisterUncountable(word string) {
+	e := defaultPluralExceptions
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.uncountable[strings.ToLower(word)] = true
+}
+
+// Pluralize returns the English plural form of the passed singular noun
+// ("file" -> "files", "box" -> "boxes", "city" -> "cities", "person" -> "people").
+// Irregular and uncountable nouns are looked up in an exception table, which can be
+// extended with RegisterPlural and RegisterUncountable; everything else follows
+// the regular suffix rules. The case of the input ("File", "FILE") is preserved.
+// Plurals shared by more than one singular resolve to the more common noun
+// ("bases" -> "base", "axes" -> "axe"); callers needing the other, such as "basis",
+// can register it with RegisterPlural.
+func Pluralize(word string) string {
+	return inflect(word, pluralize)
+}
+
+// Singularize returns the English singular form of the passed plural noun, the inverse of Pluralize
+// ("files" -> "file", "boxes" -> "box", "cities" -> "city", "people" -> "person").
+// Words which do not look plural are returned unchanged.
+func Singularize(word string) string {
+	return inflect(word, singularize)
+}
+
+func inflect(word string, fn func(e *pluralExceptions, lower string) string) string {
+	if word == "" {
+		return word
+	}
+
+	lower := strings.ToLower(word)
+
+	e := defaultPluralExceptions
+
+	e.mu.RLock()
+	res := fn(e, lower)
+	e.mu.RUnlock()
+
+	return matchCase(word, res)
+}
+
+func pluralize(e *pluralExceptions, word string) string {
+	if e.uncountable[word] {
+		return word
+	} else if plural, ok := e.plurals[word]; ok {
+		return plural
+	} else if _, ok := e.singulars[word]; ok {
+		// Already plural.
+		return word
+	}
+
+	switch {
+	case hasAnySuffix(word, "s", "x", "z", "ch", "sh"):
+		return word + "es"
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !isVowel(word[len(word)-2]):
+		return word[:len(word)-1] + "ies"
+	}
+
+	return word + "s"
+}
+
+func singularize(e *pluralExceptions, word string) string {
+	if e.uncountable[word] {
+		return word
+	} else if singular, ok := e.singulars[word]; ok {
+		return singular
+	} else if _, ok := e.plurals[word]; ok {
+		// Already singular.
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		return word[:len(word)-3] + "y"
+	case hasAnySuffix(word, "sses", "xes", "zes", "ches", "shes"):
+		return word[:len(word)-2]
+	case hasAnySuffix(word, "ss", "us", "is"):
+		return word
+	case strings.HasSuffix(word, "s"):
+		return word[:len(word)-1]
+	}
+
+	return word
+}
+
+// matchCase applies the case of src (all upper, title, or otherwise lower) to dst.
+func matchCase(src, dst string) string {
+	switch {
+	case strings.ToUpper(src) == src && strings.ToLower(src) != src:
+		return strings.ToUpper(dst)
+	case startsWithUpper(src):
+		r, size := utf8.DecodeRuneInString(dst)
+		return string(unicode.ToUpper(r)) + dst[size:]
+	}
+
+	return dst
+}
+
+func startsWithUpper(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r)
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) != -1
+}
+
+// Ordinal returns the English ordinal form of the passed integer ("1st", "22nd", "113th").
+func Ordinal[T constraints.Integer](n T) string {
+	s := formatInteger(n)
+
+	suffix := "th"
+
+	tens := 0
+	if len(s) >= 2 && isDigit(s[len(s)-2]) {
+		tens = int(s[len(s)-2] - '0')
+	}
+
+	if tens != 1 {
+		switch s[len(s)-1] {
+		case '1':
+			suffix = "st"
+		case '2':
+			suffix = "nd"
+		case '3':
+			suffix = "rd"
+		}
+	}
+
+	return s + suffix
+}
+
+// Quantity returns the passed count followed by the passed noun,
+// pluralized with Pluralize unless the count is 1 or -1 ("1 file", "3 files", "0 files").
+func Quantity[T constraints.Integer](n T, noun string) string {
+	if n == 1 || (n < 0 && n == ^T(0)) {
+		return formatInteger(n) + " " + noun
+	}
+
+	return formatInteger(n) + " " + Pluralize(noun)
+}
+
+// formatInteger formats the passed integer in base 10, ignoring any String method
+// defined on T (as fmt.Sprint would not), such that named enum types stay numeric.
+func formatInteger[T constraints.Integer](n T) string {
+	if ^T(0) < 0 {
+		return strconv.FormatInt(int64(n), 10)
+	}
+
+	return strconv.FormatUint(uint64(n), 10)
+}
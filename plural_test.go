@@ -0,0 +1,135 @@
+package xtd_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+var pluralTestCases = []struct {
+	singular string
+	plural   string
+}{
+	{"file", "files"},
+	{"box", "boxes"},
+	{"church", "churches"},
+	{"wish", "wishes"},
+	{"class", "classes"},
+	{"city", "cities"},
+	{"day", "days"},
+	{"person", "people"},
+	{"child", "children"},
+	{"knife", "knives"},
+	{"hero", "heroes"},
+	{"photo", "photos"},
+	{"analysis", "analyses"},
+	{"index", "indices"},
+	{"status", "statuses"},
+	{"quiz", "quizzes"},
+	{"sheep", "sheep"},
+	{"information", "information"},
+	{"File", "Files"},
+	{"PERSON", "PEOPLE"},
+	{"Box", "Boxes"},
+}
+
+func TestPluralize(t *testing.T) {
+	for _, tt := range pluralTestCases {
+		t.Run(tt.singular, func(t *testing.T) {
+			assert.Equal(t, tt.plural, xtd.Pluralize(tt.singular))
+		})
+	}
+
+	assert.Equal(t, "", xtd.Pluralize(""))
+	assert.Equal(t, "people", xtd.Pluralize("people"), "irregular plurals should be left as-is")
+}
+
+func TestSingularize(t *testing.T) {
+	for _, tt := range pluralTestCases {
+		t.Run(tt.plural, func(t *testing.T) {
+			assert.Equal(t, tt.singular, xtd.Singularize(tt.plural))
+		})
+	}
+
+	assert.Equal(t, "bus", xtd.Singularize("bus"))
+}
+
+func TestPluralize_roundTrip(t *testing.T) {
+	words := []string{
+		"base", "axe", "case", "house", "horse", "file", "user", "key", "process",
+		"address", "match", "box", "city", "leaf", "hero", "person", "child", "index", "status",
+	}
+
+	for _, word := range words {
+		t.Run(word, func(t *testing.T) {
+			assert.Equal(t, word, xtd.Singularize(xtd.Pluralize(word)))
+		})
+	}
+}
+
+func TestRegisterPlural(t *testing.T) {
+	assert.Equal(t, "octopuses", xtd.Pluralize("octopus"))
+
+	xtd.RegisterPlural("octopus", "octopodes")
+	assert.Equal(t, "octopodes", xtd.Pluralize("octopus"))
+	assert.Equal(t, "Octopus", xtd.Singularize("Octopodes"))
+
+	xtd.RegisterUncountable("kudos")
+	assert.Equal(t, "kudos", xtd.Pluralize("kudos"))
+	assert.Equal(t, "kudos", xtd.Singularize("kudos"))
+}
+
+// pluralTestEnum is a named integer type with a String method,
+// which Ordinal and Quantity should ignore.
+type pluralTestEnum uint8
+
+func (e pluralTestEnum) String() string {
+	return "Enum"
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		arg  int64
+		want string
+	}{
+		{0, "0th"},
+		{1, "1st"},
+		{2, "2nd"},
+		{3, "3rd"},
+		{4, "4th"},
+		{11, "11th"},
+		{12, "12th"},
+		{13, "13th"},
+		{21, "21st"},
+		{22, "22nd"},
+		{101, "101st"},
+		{111, "111th"},
+		{113, "113th"},
+		{-1, "-1st"},
+		{-12, "-12th"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Ordinal(tt.arg))
+		})
+	}
+
+	assert.Equal(t, "255th", xtd.Ordinal(uint8(math.MaxUint8)))
+	assert.Equal(t, "18446744073709551615th", xtd.Ordinal(uint64(math.MaxUint64)))
+	assert.Equal(t, "-9223372036854775808th", xtd.Ordinal(int64(math.MinInt64)))
+	assert.Equal(t, "2nd", xtd.Ordinal(pluralTestEnum(2)))
+}
+
+func TestQuantity(t *testing.T) {
+	assert.Equal(t, "1 file", xtd.Quantity(1, "file"))
+	assert.Equal(t, "3 files", xtd.Quantity(3, "file"))
+	assert.Equal(t, "0 files", xtd.Quantity(uint(0), "file"))
+	assert.Equal(t, "-1 degree", xtd.Quantity(int8(-1), "degree"))
+	assert.Equal(t, "2 children", xtd.Quantity(2, "child"))
+	assert.Equal(t, "255 boxes", xtd.Quantity(uint8(255), "box"))
+	assert.Equal(t, "3 files", xtd.Quantity(pluralTestEnum(3), "file"))
+}
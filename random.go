@@ -0,0 +1,168 @@
+package xtd
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"strings"
+)
+
+// Preset alphabets for use with RandomString and RandomStringGenerator.String.
+const (
+	// AlphabetAlphanumeric contains the ASCII letters, in both cases, and digits.
+	AlphabetAlphanumeric = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	// AlphabetHex contains the lowercase hexadecimal digits.
+	AlphabetHex = "0123456789abcdef"
+	// AlphabetURLSafe contains the characters of the URL-safe base64 alphabet (RFC 4648).
+	AlphabetURLSafe = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	// AlphabetCrockford contains the characters of Crockford's base32 alphabet,
+	// which omits the easily confused I, L, O and U.
+	AlphabetCrockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// ErrInvalidAlphabet is returned when generating a random string
+// from an alphabet which is empty or contains duplicate runes.
+var ErrInvalidAlphabet = errors.New("invalid alphabet")
+
+// RandomStringGenerator generates random strings using the bytes read from
+// an io.Reader, without the modulo bias of the commonly seen `alphabet[b % len(alphabet)]`.
+// Alphabet indices are drawn using rejection sampling: random bits are masked down to
+// the smallest power of two covering the alphabet, and out-of-range values are discarded.
+type RandomStringGenerator struct {
+	reader io.Reader
+}
+
+var defaultRandomStringGenerator = NewRandomStringGenerator(rand.Reader)
+
+// NewRandomStringGenerator constructs a RandomStringGenerator which reads randomness
+// from the passed io.Reader. Outside of tests, this should almost always be crypto/rand.Reader,
+// as the package-level Random* functions use.
+func NewRandomStringGenerator(r io.Reader) *RandomStringGenerator {
+	return &RandomStringGenerator{reader: r}
+}
+
+// String returns a random string of n runes drawn uniformly from the passed alphabet.
+// ErrInvalidAlphabet is returned if alphabet is empty or contains a rune more than once,
+// and any error from the underlying io.Reader is returned as-is.
+func (g *RandomStringGenerator) String(n int, alphabet string) (string, error) {
+	runes := []rune(alphabet)
+
+	if err := validateAlphabet(runes); err != nil {
+		return "", err
+	}
+
+	if n <= 0 {
+		return "", nil
+	}
+
+	if len(runes) == 1 {
+		return strings.Repeat(alphabet, n), nil
+	}
+
+	var (
+		maxIdx    = uint32(len(runes) - 1)
+		mask      = uint32(1)<<bits.Len32(maxIdx) - 1
+		byteCount = (bits.Len32(maxIdx) + 7) / 8
+		buf       = make([]byte, n*byteCount)
+		sb        strings.Builder
+	)
+
+	sb.Grow(n)
+
+	for count := 0; count < n; {
+		// Only read as many bytes as the runes still needed;
+		// rejected values are made up for in the next round.
+		chunk := buf[:(n-count)*byteCount]
+
+		if _, err := io.ReadFull(g.reader, chunk); err != nil {
+			return "", err
+		}
+
+		for i := 0; i < len(chunk); i += byteCount {
+			var v uint32
+			for _, b := range chunk[i : i+byteCount] {
+				v = v<<8 | uint32(b)
+			}
+
+			if v &= mask; v > maxIdx {
+				continue
+			}
+
+			sb.WriteRune(runes[v])
+			count++
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// Alphanumeric returns a random string of n characters from AlphabetAlphanumeric.
+func (g *RandomStringGenerator) Alphanumeric(n int) (string, error) {
+	return g.String(n, AlphabetAlphanumeric)
+}
+
+// Hex returns a random string of n characters from AlphabetHex.
+func (g *RandomStringGenerator) Hex(n int) (string, error) {
+	return g.String(n, AlphabetHex)
+}
+
+// URLSafe returns a random string of n characters from AlphabetURLSafe.
+func (g *RandomStringGenerator) URLSafe(n int) (string, error) {
+	return g.String(n, AlphabetURLSafe)
+}
+
+// Crockford returns a random string of n characters from AlphabetCrockford.
+func (g *RandomStringGenerator) Crockford(n int) (string, error) {
+	return g.String(n, AlphabetCrockford)
+}
+
+// RandomString returns a random string of n runes drawn uniformly from
+// the passed alphabet, using crypto/rand as its source of randomness.
+// See RandomStringGenerator.String for details.
+func RandomString(n int, alphabet string) (string, error) {
+	return defaultRandomStringGenerator.String(n, alphabet)
+}
+
+// RandomAlphanumeric returns a random string of n characters from AlphabetAlphanumeric,
+// using crypto/rand as its source of randomness.
+func RandomAlphanumeric(n int) (string, error) {
+	return defaultRandomStringGenerator.Alphanumeric(n)
+}
+
+// RandomHex returns a random string of n characters from AlphabetHex,
+// using crypto/rand as its source of randomness.
+func RandomHex(n int) (string, error) {
+	return defaultRandomStringGenerator.Hex(n)
+}
+
+// RandomURLSafe returns a random string of n characters from AlphabetURLSafe,
+// using crypto/rand as its source of randomness.
+func RandomURLSafe(n int) (string, error) {
+	return defaultRandomStringGenerator.URLSafe(n)
+}
+
+// RandomCrockford returns a random string of n characters from AlphabetCrockford,
+// using crypto/rand as its source of randomness.
+func RandomCrockford(n int) (string, error) {
+	return defaultRandomStringGenerator.Crockford(n)
+}
+
+func validateAlphabet(runes []rune) error {
+	if len(runes) == 0 {
+		return fmt.Errorf("%w: empty", ErrInvalidAlphabet)
+	}
+
+	seen := make(map[rune]bool, len(runes))
+
+	for _, r := range runes {
+		if seen[r] {
+			return fmt.Errorf("%w: duplicate rune %q", ErrInvalidAlphabet, r)
+		}
+
+		seen[r] = true
+	}
+
+	return nil
+}
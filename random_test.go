@@ -0,0 +1,101 @@
+package xtd_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestRandomStringGenerator_String(t *testing.T) {
+	t.Run("exactly enough bytes", func(t *testing.T) {
+		gen := xtd.NewRandomStringGenerator(bytes.NewReader([]byte{0, 1, 2, 3}))
+
+		got, err := gen.String(4, "abcd")
+		assert.NoError(t, err)
+		assert.Equal(t, "abcd", got)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		// With a 3-rune alphabet, values are masked to 2 bits,
+		// and 3 (0b11) is rejected rather than wrapped around.
+		src := bytes.NewReader([]byte{0, 1, 2, 3, 7, 4, 5, 6, 0xff, 0xfe})
+		gen := xtd.NewRandomStringGenerator(src)
+
+		got, err := gen.String(5, "abc")
+		assert.NoError(t, err)
+		assert.Equal(t, "abcab", got)
+		assert.Equal(t, 3, src.Len(), "only the bytes needed should be read")
+	})
+
+	t.Run("unicode alphabet", func(t *testing.T) {
+		gen := xtd.NewRandomStringGenerator(bytes.NewReader([]byte{0, 1, 0, 1}))
+
+		got, err := gen.String(2, "日本")
+		assert.NoError(t, err)
+		assert.Equal(t, "日本", got)
+	})
+
+	t.Run("single rune alphabet", func(t *testing.T) {
+		got, err := xtd.NewRandomStringGenerator(errReader{}).String(3, "x")
+		assert.NoError(t, err)
+		assert.Equal(t, "xxx", got)
+	})
+
+	t.Run("invalid alphabet", func(t *testing.T) {
+		_, err := xtd.RandomString(5, "")
+		assert.ErrorIs(t, err, xtd.ErrInvalidAlphabet)
+
+		_, err = xtd.RandomString(5, "abca")
+		assert.ErrorIs(t, err, xtd.ErrInvalidAlphabet)
+	})
+
+	t.Run("reader error", func(t *testing.T) {
+		_, err := xtd.NewRandomStringGenerator(errReader{}).Hex(8)
+		assert.EqualError(t, err, "read failed")
+	})
+}
+
+func TestRandomPresets(t *testing.T) {
+	presets := []struct {
+		name     string
+		fn       func(int) (string, error)
+		alphabet string
+	}{
+		{"alphanumeric", xtd.RandomAlphanumeric, xtd.AlphabetAlphanumeric},
+		{"hex", xtd.RandomHex, xtd.AlphabetHex},
+		{"url safe", xtd.RandomURLSafe, xtd.AlphabetURLSafe},
+		{"crockford", xtd.RandomCrockford, xtd.AlphabetCrockford},
+	}
+
+	for _, p := range presets {
+		t.Run(p.name, func(t *testing.T) {
+			got, err := p.fn(4096)
+			assert.NoError(t, err)
+			assert.Equal(t, 4096, utf8.RuneCountInString(got))
+
+			for _, r := range p.alphabet {
+				assert.True(t, strings.ContainsRune(got, r), "expected %q to appear in output", r)
+			}
+
+			for _, r := range got {
+				assert.True(t, strings.ContainsRune(p.alphabet, r), "unexpected %q in output", r)
+			}
+		})
+	}
+
+	got, err := xtd.RandomHex(0)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
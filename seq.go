@@ -0,0 +1,216 @@
+package xtd
+
+// Seq is a lazy sequence of values, which calls yield with each value in turn
+// until the sequence is exhausted or yield returns false.
+// Operations on a Seq, such as SeqMap and Filter, return a new Seq which does no work
+// until it is iterated, so pipelines never materialise intermediate slices.
+// Operations which change the value type or constrain it (SeqMap, SeqChunk and SeqUniq)
+// are functions, as Go methods cannot introduce type parameters; the rest are methods.
+//
+// Seq has the same underlying type as the standard library's iter.Seq,
+// so a Seq can be converted to one, and ranged over directly, on Go 1.23 and later.
+type Seq[T any] func(yield func(T) bool)
+
+// SeqFromSlice returns a Seq over the entries of the passed slice, in order.
+func SeqFromSlice[T any](data []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, d := range data {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// SeqFromChan returns a Seq over the values received from the passed channel,
+// which is exhausted once the channel is closed. Iteration blocks while waiting
+// for values. If iteration stops early, any remaining values are left in the channel.
+func SeqFromChan[T any](ch <-chan T) Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqFromMapKeys returns a Seq over the keys of the passed map, in unspecified order.
+func SeqFromMapKeys[K comparable, V any](m map[K]V) Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SeqFromMapValues returns a Seq over the values of the passed map, in unspecified order.
+func SeqFromMapValues[K comparable, V any](m map[K]V) Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqFromMap returns a Seq over the key/value pairs of the passed map, in unspecified order.
+func SeqFromMap[K comparable, V any](m map[K]V) Seq[Pair[K, V]] {
+	return func(yield func(Pair[K, V]) bool) {
+		for k, v := range m {
+			if !yield(Pair[K, V]{k, v}) {
+				return
+			}
+		}
+	}
+}
+
+// SeqFromFunc returns a Seq over the values returned by the passed generator function,
+// which is called for each value until it returns false.
+func SeqFromFunc[T any](next func() (T, bool)) Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := next()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqIterate returns an infinite Seq of seed, fn(seed), fn(fn(seed)), and so on.
+// It must be bounded, such as with Take, before being collected.
+func SeqIterate[T any](seed T, fn UnaryFn[T, T]) Seq[T] {
+	return func(yield func(T) bool) {
+		for v := seed; yield(v); v = fn(v) {
+		}
+	}
+}
+
+// SeqMap returns a Seq of the output of the passed UnaryFn for each value of the passed Seq.
+func SeqMap[T, U any](s Seq[T], fn UnaryFn[T, U]) Seq[U] {
+	return func(yield func(U) bool) {
+		s(func(v T) bool {
+			return yield(fn(v))
+		})
+	}
+}
+
+// SeqUniq returns a Seq of the values of the passed Seq, skipping any
+// which have already been seen. The values seen are held in memory
+// for the duration of each iteration.
+func SeqUniq[T comparable](s Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+
+		s(func(v T) bool {
+			if _, ok := seen[v]; ok {
+				return true
+			}
+
+			seen[v] = struct{}{}
+
+			return yield(v)
+		})
+	}
+}
+
+// SeqChunk returns a Seq of consecutive chunks of n values of the passed Seq,
+// the last of which holds the remainder and may be shorter.
+// Each chunk is a newly allocated slice, so chunks may be retained.
+// SeqChunk panics if n is less than 1.
+func SeqChunk[T any](s Seq[T], n int) Seq[[]T] {
+	if n < 1 {
+		panic("xtd: SeqChunk size must be at least 1")
+	}
+
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, n)
+		stopped := false
+
+		s(func(v T) bool {
+			chunk = append(chunk, v)
+			if len(chunk) < n {
+				return true
+			}
+
+			if !yield(chunk) {
+				stopped = true
+				return false
+			}
+
+			chunk = make([]T, 0, n)
+
+			return true
+		})
+
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Filter returns a Seq of the values of s for which the passed FilterFn returns true.
+func (s Seq[T]) Filter(fn FilterFn[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(v T) bool {
+			return !fn(v) || yield(v)
+		})
+	}
+}
+
+// Take returns a Seq of at most the first n values of s.
+// s is not iterated any further once n values have been yielded.
+func (s Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+
+		s(func(v T) bool {
+			taken++
+			return yield(v) && taken < n
+		})
+	}
+}
+
+// Skip returns a Seq of the values of s after the first n.
+func (s Seq[T]) Skip(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+
+		s(func(v T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+
+			return yield(v)
+		})
+	}
+}
+
+// ForEach calls the passed function with each value of s.
+func (s Seq[T]) ForEach(fn func(T)) {
+	s(func(v T) bool {
+		fn(v)
+		return true
+	})
+}
+
+// Collect iterates s to exhaustion, returning a newly allocated slice of its values.
+func (s Seq[T]) Collect() []T {
+	res := []T{}
+
+	s(func(v T) bool {
+		res = append(res, v)
+		return true
+	})
+
+	return res
+}
@@ -0,0 +1,98 @@
+package xtd_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestSeq_pipeline(t *testing.T) {
+	var mapped int
+
+	s := xtd.SeqMap(xtd.SeqFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8}).Filter(isEven), func(n int) string {
+		mapped++
+		return strconv.Itoa(n * 10)
+	})
+
+	assert.Zero(t, mapped, "nothing should run until the Seq is iterated")
+
+	assert.Equal(t, []string{"40", "60"}, s.Skip(1).Take(2).Collect())
+	assert.Equal(t, 3, mapped, "iteration should stop once Take is satisfied")
+}
+
+func TestSeqFromChan(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+
+	close(ch)
+
+	assert.Equal(t, []int{1, 2}, xtd.SeqFromChan(ch).Take(2).Collect())
+	assert.Equal(t, []int{3, 4, 5}, xtd.SeqFromChan(ch).Collect(), "unconsumed values remain in the channel")
+}
+
+func TestSeqFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, xtd.SeqFromMapKeys(m).Collect())
+	assert.ElementsMatch(t, []int{1, 2, 3}, xtd.SeqFromMapValues(m).Collect())
+	assert.ElementsMatch(t, []xtd.Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}, xtd.SeqFromMap(m).Collect())
+}
+
+func TestSeqFromFunc(t *testing.T) {
+	lines := strings.Split("a\nb\nc", "\n")
+
+	i := 0
+	next := func() (string, bool) {
+		if i == len(lines) {
+			return "", false
+		}
+
+		i++
+
+		return lines[i-1], true
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, xtd.SeqFromFunc(next).Collect())
+}
+
+func TestSeqIterate(t *testing.T) {
+	powers := xtd.SeqIterate(1, func(n int) int {
+		return n * 2
+	})
+
+	assert.Equal(t, []int{1, 2, 4, 8, 16}, powers.Take(5).Collect())
+	assert.Equal(t, []int{}, powers.Take(0).Collect())
+}
+
+func TestSeqUniq(t *testing.T) {
+	s := xtd.SeqUniq(xtd.SeqFromSlice(sliceUniqStringArgs))
+
+	assert.Equal(t, sliceUniqStringWant, s.Collect())
+	assert.Equal(t, sliceUniqStringWant, s.Collect(), "each iteration starts afresh")
+}
+
+func TestSeqChunk(t *testing.T) {
+	s := xtd.SeqFromSlice([]int{1, 2, 3, 4, 5})
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, xtd.SeqChunk(s, 2).Collect())
+	assert.Equal(t, [][]int{{1, 2}}, xtd.SeqChunk(s, 2).Take(1).Collect())
+	assert.Equal(t, [][]int{}, xtd.SeqChunk(xtd.SeqFromSlice([]int(nil)), 2).Collect())
+
+	assert.Panics(t, func() { xtd.SeqChunk(s, 0) })
+}
+
+func TestSeq_ForEach(t *testing.T) {
+	sum := 0
+
+	xtd.SeqFromSlice([]int{1, 2, 3}).ForEach(func(n int) {
+		sum += n
+	})
+
+	assert.Equal(t, 6, sum)
+}
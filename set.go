@@ -0,0 +1,272 @@
+package xtd
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// Set is an unordered collection of unique values, which additionally
+// remembers the order in which its members were added, such that Slice,
+// JSON marshaling and the set operations return members in insertion order.
+// The zero value is an empty set ready to use.
+// A Set is not safe for concurrent use; see SyncSet.
+type Set[T comparable] struct {
+	members map[T]uint64
+	seq     uint64
+}
+
+// NewSet returns a new Set containing the passed values.
+func NewSet[T comparable](values ...T) *Set[T] {
+	return SetFromSlice(values)
+}
+
+// SetFromSlice returns a new Set containing the entries of the passed slice,
+// ordered by the first appearance of each entry, as with SliceUniqSafe.
+func SetFromSlice[T comparable](data []T) *Set[T] {
+	s := &Set[T]{members: make(map[T]uint64, len(data))}
+	s.Add(data...)
+
+	return s
+}
+
+// Add adds the passed values to the set.
+// Values which are already members keep their original position.
+func (s *Set[T]) Add(values ...T) {
+	if s.members == nil {
+		s.members = make(map[T]uint64, len(values))
+	}
+
+	for _, v := range values {
+		if _, ok := s.members[v]; !ok {
+			s.members[v] = s.seq
+			s.seq++
+		}
+	}
+}
+
+// Remove removes the passed values from the set, if they are members.
+func (s *Set[T]) Remove(values ...T) {
+	for _, v := range values {
+		delete(s.members, v)
+	}
+}
+
+// Has returns whether the passed value is a member of the set.
+func (s *Set[T]) Has(value T) bool {
+	_, ok := s.members[value]
+	return ok
+}
+
+// Len returns the number of members of the set.
+func (s *Set[T]) Len() int {
+	return len(s.members)
+}
+
+// Clear removes all members from the set.
+func (s *Set[T]) Clear() {
+	s.members = nil
+	s.seq = 0
+}
+
+// Clone returns a new Set with the same members, in the same order.
+func (s *Set[T]) Clone() *Set[T] {
+	return SetFromSlice(s.Slice())
+}
+
+// Slice returns a newly allocated slice containing the members of the set,
+// in the order they were added.
+func (s *Set[T]) Slice() []T {
+	res := make([]T, 0, len(s.members))
+	for v := range s.members {
+		res = append(res, v)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return s.members[res[i]] < s.members[res[j]]
+	})
+
+	return res
+}
+
+// Union returns a new Set containing the members of both sets,
+// with the members of s first.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	res := s.Clone()
+	res.Add(other.Slice()...)
+
+	return res
+}
+
+// Intersection returns a new Set containing the members of s which are also members of other.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	return SetFromSlice(FilterSlice(s.Slice(), other.Has))
+}
+
+// Difference returns a new Set containing the members of s which are not members of other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return SetFromSlice(FilterSlice(s.Slice(), func(v T) bool {
+		return !other.Has(v)
+	}))
+}
+
+// SymmetricDifference returns a new Set containing the members of exactly one of the sets,
+// with those of s first.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	res := s.Difference(other)
+	res.Add(other.Difference(s).Slice()...)
+
+	return res
+}
+
+// IsSubset returns whether every member of s is also a member of other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	for v := range s.members {
+		if !other.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset returns whether every member of other is also a member of s.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns whether both sets have the same members, regardless of order.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// MarshalJSON marshals the set as a JSON array of its members, in insertion order.
+// It has a value receiver so that a Set held by value, such as a struct field, marshals as an array too.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON replaces the members of the set with those of the passed JSON array.
+// Duplicate entries are ignored.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*s = *SetFromSlice(values)
+
+	return nil
+}
+
+// SyncSet is a Set which is safe for concurrent use.
+// The zero value is an empty set ready to use.
+// The set operations are available on a consistent copy obtained with Snapshot.
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// NewSyncSet returns a new SyncSet containing the passed values.
+func NewSyncSet[T comparable](values ...T) *SyncSet[T] {
+	s := new(SyncSet[T])
+	s.set.Add(values...)
+
+	return s
+}
+
+// Add adds the passed values to the set.
+func (s *SyncSet[T]) Add(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Add(values...)
+}
+
+// AddIfAbsent adds the passed value to the set, returning whether it was not already a member.
+// Unlike calling Has and then Add, the check and insertion happen atomically.
+func (s *SyncSet[T]) AddIfAbsent(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.set.Has(value) {
+		return false
+	}
+
+	s.set.Add(value)
+
+	return true
+}
+
+// Remove removes the passed values from the set, if they are members.
+func (s *SyncSet[T]) Remove(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Remove(values...)
+}
+
+// Has returns whether the passed value is a member of the set.
+func (s *SyncSet[T]) Has(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Has(value)
+}
+
+// Len returns the number of members of the set.
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Len()
+}
+
+// Clear removes all members from the set.
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Clear()
+}
+
+// Slice returns a newly allocated slice containing the members of the set,
+// in the order they were added.
+func (s *SyncSet[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Slice()
+}
+
+// Snapshot returns a copy of the set's current members as a (non-concurrent) Set.
+func (s *SyncSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Clone()
+}
+
+// MarshalJSON marshals the set as a JSON array of its members, in insertion order.
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON replaces the members of the set with those of the passed JSON array.
+func (s *SyncSet[T]) UnmarshalJSON(data []byte) error {
+	var set Set[T]
+	if err := set.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set = set
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package xtd_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestSet(t *testing.T) {
+	s := xtd.NewSet("c", "a", "b", "a")
+
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Has("a"))
+	assert.False(t, s.Has("d"))
+	assert.Equal(t, []string{"c", "a", "b"}, s.Slice())
+
+	s.Add("d", "c")
+	assert.Equal(t, []string{"c", "a", "b", "d"}, s.Slice())
+
+	s.Remove("a", "z")
+	assert.Equal(t, []string{"c", "b", "d"}, s.Slice())
+
+	s.Add("a")
+	assert.Equal(t, []string{"c", "b", "d", "a"}, s.Slice(), "re-added members go last")
+
+	clone := s.Clone()
+	clone.Add("e")
+	assert.False(t, s.Has("e"))
+
+	s.Clear()
+	assert.Zero(t, s.Len())
+	assert.Empty(t, s.Slice())
+
+	t.Run("zero value", func(t *testing.T) {
+		var s xtd.Set[int]
+		assert.False(t, s.Has(1))
+
+		s.Add(1)
+		assert.True(t, s.Has(1))
+	})
+
+	t.Run("from slice", func(t *testing.T) {
+		assert.Equal(t, sliceUniqUintWant, xtd.SetFromSlice(sliceUniqUintArgs).Slice())
+	})
+}
+
+func TestSet_algebra(t *testing.T) {
+	a := xtd.NewSet(1, 2, 3, 4)
+	b := xtd.NewSet(6, 4, 5, 3)
+
+	assert.Equal(t, []int{1, 2, 3, 4, 6, 5}, a.Union(b).Slice())
+	assert.Equal(t, []int{3, 4}, a.Intersection(b).Slice())
+	assert.Equal(t, []int{1, 2}, a.Difference(b).Slice())
+	assert.Equal(t, []int{1, 2, 6, 5}, a.SymmetricDifference(b).Slice())
+
+	assert.Equal(t, []int{1, 2, 3, 4}, a.Slice(), "operands are not modified")
+
+	sub := xtd.NewSet(4, 2)
+	assert.True(t, sub.IsSubset(a))
+	assert.False(t, sub.IsSubset(b))
+	assert.True(t, a.IsSuperset(sub))
+	assert.True(t, xtd.NewSet[int]().IsSubset(a))
+
+	assert.True(t, a.Equal(xtd.NewSet(4, 3, 2, 1)))
+	assert.False(t, a.Equal(sub))
+}
+
+func TestSet_JSON(t *testing.T) {
+	type payload struct {
+		Tags *xtd.Set[string] `json:"tags"`
+	}
+
+	data, err := json.Marshal(payload{xtd.NewSet("b", "a")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":["b","a"]}`, string(data))
+
+	var got payload
+	require.NoError(t, json.Unmarshal([]byte(`{"tags":["x","y","x"]}`), &got))
+	assert.Equal(t, []string{"x", "y"}, got.Tags.Slice())
+
+	var s xtd.Set[int]
+	assert.Error(t, json.Unmarshal([]byte(`{"a":1}`), &s))
+
+	t.Run("by value", func(t *testing.T) {
+		type payload struct {
+			Tags xtd.Set[string] `json:"tags"`
+		}
+
+		data, err := json.Marshal(payload{*xtd.NewSet("b", "a")})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"tags":["b","a"]}`, string(data))
+
+		var got payload
+		require.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, []string{"b", "a"}, got.Tags.Slice())
+
+		data, err = json.Marshal(payload{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"tags":[]}`, string(data), "the zero value marshals as an empty array")
+	})
+}
+
+func TestSyncSet(t *testing.T) {
+	s := xtd.NewSyncSet[int]()
+
+	var (
+		wg    sync.WaitGroup
+		added int32
+		mu    sync.Mutex
+	)
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			s.Add(i % 10)
+
+			if s.AddIfAbsent(100) {
+				mu.Lock()
+				added++
+				mu.Unlock()
+			}
+
+			_ = s.Has(i)
+			_ = s.Slice()
+		}(i)
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 11, s.Len())
+	assert.Equal(t, int32(1), added)
+
+	snap := s.Snapshot()
+	s.Remove(100)
+	assert.True(t, snap.Has(100))
+	assert.False(t, s.Has(100))
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+
+	var got xtd.SyncSet[int]
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.ElementsMatch(t, s.Slice(), got.Slice())
+
+	s.Clear()
+	assert.Zero(t, s.Len())
+}
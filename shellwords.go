@@ -0,0 +1,160 @@
+package xtd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrUnterminatedQuote is returned (wrapped in a *ShellWordsError) by SplitShellWords
+	// when a single- or double-quoted string is not closed.
+	ErrUnterminatedQuote = errors.New("unterminated quote")
+
+	// ErrTrailingBackslash is returned (wrapped in a *ShellWordsError) by SplitShellWords
+	// when the input ends with an unescaped backslash.
+	ErrTrailingBackslash = errors.New("trailing backslash")
+)
+
+// ShellWordsError is returned by SplitShellWords when its input
+// cannot be split, recording the byte offset in the input
+// at which the offending quote or backslash appears.
+type ShellWordsError struct {
+	Err error
+	Pos int
+}
+
+func (e *ShellWordsError) Error() string {
+	return fmt.Sprintf("shell words: %v at position %d", e.Err, e.Pos)
+}
+
+func (e *ShellWordsError) Unwrap() error {
+	return e.Err
+}
+
+// SplitShellWords splits the passed string into words the way a POSIX shell would,
+// without performing any expansion or invoking a shell.
+// Words are separated by unquoted spaces, tabs and newlines.
+// Single quotes preserve everything up to the next single quote literally,
+// double quotes preserve everything except for backslash escapes of
+// '$', '`', '"', '\' and newline, and outside of quotes a backslash escapes
+// any following character. An escaped newline is removed entirely.
+// If a quote is left unterminated or the input ends with a lone backslash,
+// a *ShellWordsError is returned.
+func SplitShellWords(s string) (words []string, err error) {
+	var (
+		word    strings.Builder
+		inWord  bool
+		quote   byte
+		quoteAt int
+	)
+
+	words = []string{}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch quote {
+		case '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				word.WriteByte(c)
+			}
+
+			continue
+		case '"':
+			switch c {
+			case '"':
+				quote = 0
+			case '\\':
+				if i+1 == len(s) {
+					return nil, &ShellWordsError{ErrUnterminatedQuote, quoteAt}
+				}
+
+				i++
+
+				switch s[i] {
+				case '$', '`', '"', '\\':
+					word.WriteByte(s[i])
+				case '\n':
+				default:
+					word.WriteByte('\\')
+					word.WriteByte(s[i])
+				}
+			default:
+				word.WriteByte(c)
+			}
+
+			continue
+		}
+
+		switch c {
+		case ' ', '\t', '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case '\'', '"':
+			quote, quoteAt = c, i
+			inWord = true
+		case '\\':
+			if i+1 == len(s) {
+				return nil, &ShellWordsError{ErrTrailingBackslash, i}
+			}
+
+			i++
+
+			if s[i] != '\n' {
+				word.WriteByte(s[i])
+				inWord = true
+			}
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, &ShellWordsError{ErrUnterminatedQuote, quoteAt}
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return
+}
+
+// QuoteShellWord returns the passed string quoted such that a POSIX shell
+// (or SplitShellWords) would interpret it as a single word with the same value.
+// Strings made up solely of characters which need no quoting are returned as-is;
+// anything else is wrapped in single quotes.
+func QuoteShellWord(s string) string {
+	if s == "" {
+		return "''"
+	}
+
+	if strings.IndexFunc(s, needsShellQuote) == -1 {
+		return s
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// JoinShellWords quotes each of the passed words with QuoteShellWord
+// and joins them with spaces, such that SplitShellWords on the
+// result returns the original words.
+func JoinShellWords(words []string) string {
+	return strings.Join(MapSlice(words, QuoteShellWord), " ")
+}
+
+func needsShellQuote(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	}
+
+	return !strings.ContainsRune("_-.,:/@%+=", r)
+}
@@ -0,0 +1,93 @@
+package xtd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestSplitShellWords(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want []string
+	}{
+		{"empty", "", []string{}},
+		{"whitespace", " \t\n ", []string{}},
+		{"simple", "run --name job", []string{"run", "--name", "job"}},
+		{"single quotes", "run --name 'my job'", []string{"run", "--name", "my job"}},
+		{"double quotes", `echo "a \"b\" \$c \d"`, []string{"echo", `a "b" $c \d`}},
+		{"single quotes literal", `echo 'a \"b\"'`, []string{"echo", `a \"b\"`}},
+		{"backslash", `a\ b c\\d`, []string{"a b", `c\d`}},
+		{"empty quoted", `a '' ""`, []string{"a", "", ""}},
+		{"adjacent quotes", `--opt="a b"'c d'e`, []string{"--opt=a bc de"}},
+		{"line continuation", "a \\\nb", []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.SplitShellWords(tt.arg)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitShellWords_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		wantErr error
+		wantPos int
+	}{
+		{"unterminated single", "run 'my job", xtd.ErrUnterminatedQuote, 4},
+		{"unterminated double", `run "my job`, xtd.ErrUnterminatedQuote, 4},
+		{"escaped closing quote", `run "my job\"`, xtd.ErrUnterminatedQuote, 4},
+		{"trailing backslash", `run job\`, xtd.ErrTrailingBackslash, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xtd.SplitShellWords(tt.arg)
+			assert.Nil(t, got)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var swErr *xtd.ShellWordsError
+			if assert.ErrorAs(t, err, &swErr) {
+				assert.Equal(t, tt.wantPos, swErr.Pos)
+			}
+		})
+	}
+}
+
+func TestQuoteShellWord(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{"empty", "", "''"},
+		{"safe", "--name=job-1.2", "--name=job-1.2"},
+		{"space", "my job", "'my job'"},
+		{"single quote", "it's", `'it'\''s'`},
+		{"metacharacters", "$HOME; rm", "'$HOME; rm'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.QuoteShellWord(tt.arg))
+		})
+	}
+}
+
+func TestJoinShellWords(t *testing.T) {
+	words := []string{"run", "--name", "my job", "it's", "", `back\slash "quoted"`, "tab\there"}
+
+	joined := xtd.JoinShellWords(words)
+
+	got, err := xtd.SplitShellWords(joined)
+	assert.NoError(t, err)
+	assert.Equal(t, words, got)
+}
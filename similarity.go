@@ -0,0 +1,302 @@
+package xtd
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Levenshtein returns the Levenshtein edit distance between the two passed strings,
+// that being the minimum number of single-rune insertions, deletions
+// and substitutions required to turn a into b.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	if len(ra) == 0 {
+		return len(rb)
+	} else if len(rb) == 0 {
+		return len(ra)
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = minOf(minOf(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// DamerauLevenshtein returns the Damerau-Levenshtein edit distance between
+// the two passed strings. It is identical to Levenshtein, except that
+// transposing two adjacent runes counts as a single edit,
+// making it better suited to catching typos ("teh" -> "the").
+func DamerauLevenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	if len(ra) == 0 {
+		return len(rb)
+	} else if len(rb) == 0 {
+		return len(ra)
+	}
+
+	var (
+		maxDist = len(ra) + len(rb)
+		lastRow = make(map[rune]int)
+		d       = make([][]int, len(ra)+2)
+	)
+
+	for i := range d {
+		d[i] = make([]int, len(rb)+2)
+	}
+
+	d[0][0] = maxDist
+
+	for i := 0; i <= len(ra); i++ {
+		d[i+1][0] = maxDist
+		d[i+1][1] = i
+	}
+
+	for j := 0; j <= len(rb); j++ {
+		d[0][j+1] = maxDist
+		d[1][j+1] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		lastMatchCol := 0
+
+		for j := 1; j <= len(rb); j++ {
+			var (
+				k    = lastRow[rb[j-1]]
+				l    = lastMatchCol
+				cost = 1
+			)
+
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+				lastMatchCol = j
+			}
+
+			d[i+1][j+1] = minOf(
+				minOf(d[i][j]+cost, d[i+1][j]+1),
+				minOf(d[i][j+1]+1, d[k][l]+(i-k-1)+1+(j-l-1)),
+			)
+		}
+
+		lastRow[ra[i-1]] = i
+	}
+
+	return d[len(ra)+1][len(rb)+1]
+}
+
+// Jaro returns the Jaro similarity of the two passed strings,
+// in the range [0, 1], where 1 indicates an exact match.
+func Jaro(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	} else if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	matchDist := maxOf(len(ra), len(rb))/2 - 1
+	if matchDist < 0 {
+		matchDist = 0
+	}
+
+	var (
+		matchesA = make([]bool, len(ra))
+		matchesB = make([]bool, len(rb))
+		matches  int
+	)
+
+	for i := range ra {
+		lo := maxOf(0, i-matchDist)
+		hi := minOf(len(rb), i+matchDist+1)
+
+		for j := lo; j < hi; j++ {
+			if matchesB[j] || ra[i] != rb[j] {
+				continue
+			}
+
+			matchesA[i], matchesB[j] = true, true
+			matches++
+
+			break
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	var transpositions, j int
+
+	for i := range ra {
+		if !matchesA[i] {
+			continue
+		}
+
+		for !matchesB[j] {
+			j++
+		}
+
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+
+		j++
+	}
+
+	m := float64(matches)
+
+	return (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of the two passed strings,
+// in the range [0, 1], where 1 indicates an exact match.
+// Strings sharing a common prefix (of up to 4 runes) are scored
+// more favorably than they would be by Jaro.
+func JaroWinkler(a, b string) float64 {
+	const (
+		scalingFactor = 0.1
+		maxPrefix     = 4
+	)
+
+	sim := Jaro(a, b)
+
+	ra, rb := []rune(a), []rune(b)
+
+	prefix := 0
+	for prefix < minOf(maxPrefix, minOf(len(ra), len(rb))) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+
+	return sim + float64(prefix)*scalingFactor*(1-sim)
+}
+
+// LongestCommonSubsequence returns the longest sequence of runes
+// which appears, in order but not necessarily contiguously, in both
+// of the passed strings.
+// If several such sequences exist, any one of them may be returned,
+// though the result is always the same for the same inputs.
+func LongestCommonSubsequence(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+
+	lengths := make([][]int, len(ra)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(rb)+1)
+	}
+
+	for i := len(ra) - 1; i >= 0; i-- {
+		for j := len(rb) - 1; j >= 0; j-- {
+			if ra[i] == rb[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = maxOf(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	res := make([]rune, 0, lengths[0][0])
+
+	for i, j := 0, 0; i < len(ra) && j < len(rb); {
+		switch {
+		case ra[i] == rb[j]:
+			res = append(res, ra[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return string(res)
+}
+
+// ClosestMatches returns up to n entries from candidates which most closely
+// resemble input, best match first, for use in "did you mean ...?" suggestions.
+// Matching is case-insensitive. Candidates are ranked by DamerauLevenshtein distance,
+// with ties broken by JaroWinkler similarity and then by their order in candidates.
+// Candidates whose distance from input exceeds half the length of the longer
+// of the two strings are considered unrelated and are never returned.
+// If n is less than 1, all matching candidates are returned.
+func ClosestMatches(input string, candidates []string, n int) []string {
+	type match struct {
+		candidate string
+		dist      int
+		sim       float64
+	}
+
+	input = strings.ToLower(input)
+	inputLen := utf8.RuneCountInString(input)
+
+	matches := make([]match, 0, len(candidates))
+
+	for _, c := range candidates {
+		lower := strings.ToLower(c)
+
+		dist := DamerauLevenshtein(input, lower)
+		if dist*2 > maxOf(inputLen, utf8.RuneCountInString(lower)) {
+			continue
+		}
+
+		matches = append(matches, match{c, dist, JaroWinkler(input, lower)})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].dist != matches[j].dist {
+			return matches[i].dist < matches[j].dist
+		}
+
+		return matches[i].sim > matches[j].sim
+	})
+
+	if n > 0 && len(matches) > n {
+		matches = matches[:n]
+	}
+
+	res := make([]string, len(matches))
+	for i := range matches {
+		res[i] = matches[i].candidate
+	}
+
+	return res
+}
+
+func minOf[T constraints.Ordered](a, b T) T {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxOf[T constraints.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,118 @@
+package xtd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+type distanceTestCase struct {
+	name string
+	a, b string
+	want int
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []distanceTestCase{
+		{"empty", "", "", 0},
+		{"empty a", "", "abc", 3},
+		{"empty b", "abc", "", 3},
+		{"equal", "verbose", "verbose", 0},
+		{"deletion", "verbos", "verbose", 1},
+		{"kitten", "kitten", "sitting", 3},
+		{"transposition", "teh", "the", 2},
+		{"multibyte", "café", "cafe", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Levenshtein(tt.a, tt.b))
+			assert.Equal(t, tt.want, xtd.Levenshtein(tt.b, tt.a))
+		})
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	tests := []distanceTestCase{
+		{"empty", "", "", 0},
+		{"empty a", "", "abc", 3},
+		{"equal", "verbose", "verbose", 0},
+		{"deletion", "LOG_LEVL", "LOG_LEVEL", 1},
+		{"transposition", "teh", "the", 1},
+		{"non-adjacent transposition", "ca", "abc", 2},
+		{"multibyte", "日本語", "日語本", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.DamerauLevenshtein(tt.a, tt.b))
+			assert.Equal(t, tt.want, xtd.DamerauLevenshtein(tt.b, tt.a))
+		})
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"empty", "", "", 1},
+		{"one empty", "", "abc", 0},
+		{"equal", "verbose", "verbose", 1},
+		{"no match", "abc", "xyz", 0},
+		{"martha", "MARTHA", "MARHTA", 0.9611},
+		{"dixon", "DIXON", "DICKSONX", 0.8133},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, xtd.JaroWinkler(tt.a, tt.b), 0.0001)
+		})
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"empty", "", "abc", ""},
+		{"equal", "abc", "abc", "abc"},
+		{"simple", "ABCBDAB", "BDCABA", "BDAB"},
+		{"multibyte", "naïve café", "naive cafe", "nave caf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.LongestCommonSubsequence(tt.a, tt.b))
+		})
+	}
+}
+
+func TestClosestMatches(t *testing.T) {
+	flags := []string{"--version", "--verbose", "--help", "--output", "--verify"}
+
+	tests := []struct {
+		name       string
+		input      string
+		candidates []string
+		n          int
+		want       []string
+	}{
+		{"single", "--verbos", flags, 1, []string{"--verbose"}},
+		{"ranked", "--verbos", flags, 3, []string{"--verbose", "--version", "--verify"}},
+		{"case-insensitive", "log_levl", []string{"LOG_FILE", "LOG_LEVEL"}, 1, []string{"LOG_LEVEL"}},
+		{"unrelated", "--zzz", flags, 0, []string{}},
+		{"no candidates", "--verbos", nil, 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.ClosestMatches(tt.input, tt.candidates, tt.n))
+		})
+	}
+}
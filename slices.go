@@ -2,28 +2,11 @@ package xtd
 
 import (
 	"context"
-	"sort"
+	"errors"
+	"fmt"
+	"strings"
 )
 
-type sliceEntry[T any] struct {
-	d   T
-	idx int
-}
-
-type sliceEntries[T any] []sliceEntry[T]
-
-func (s sliceEntries[T]) Len() int {
-	return len(s)
-}
-
-func (s sliceEntries[T]) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
-}
-
-func (s sliceEntries[T]) Less(i, j int) bool {
-	return s[i].idx < s[j].idx
-}
-
 // SliceUniq returns a newly allocated slice containing
 // only unique entries from the passed slice.
 // This function does _not_ guarantee ordering of the returned slice.
@@ -50,26 +33,110 @@ func SliceUniq[T comparable](data []T) (res []T) {
 // SliceUniqSafe returns a newly allocated slice containing
 // only unique entries from the passed slice.
 // This function does guarantee ordering of the returned slice
-// respective to the passed slice, at the cost of a tad extra
-// computational complexity.
+// respective to the passed slice, keeping the first occurrence of each entry.
+// It runs in a single pass over a presized set of the entries seen, and allocates
+// the result once with capacity len(data); callers which retain a result much shorter
+// than the passed slice may wish to copy it (or use SliceUniqInPlace).
 func SliceUniqSafe[T comparable](data []T) (res []T) {
-	m := make(map[T]int)
+	seen := make(map[T]struct{}, len(data))
+	res = make([]T, 0, len(data))
 
-	var entries sliceEntries[T]
+	for _, d := range data {
+		if _, ok := seen[d]; !ok {
+			seen[d] = struct{}{}
+			res = append(res, d)
+		}
+	}
 
-	for i, d := range data {
-		if _, ok := m[d]; !ok {
-			m[d] = i
-			entries = append(entries, sliceEntry[T]{d, i})
+	return
+}
+
+// SliceUniqInPlace removes duplicate entries from the passed slice in place,
+// keeping the first occurrence of each entry in its original order,
+// and returns the compacted slice, which shares the passed slice's backing array.
+// The entries beyond the returned slice's length are zeroed,
+// so that they do not keep any referenced memory alive.
+func SliceUniqInPlace[T comparable](data []T) []T {
+	seen := make(map[T]struct{}, len(data))
+	n := 0
+
+	for _, d := range data {
+		if _, ok := seen[d]; !ok {
+			seen[d] = struct{}{}
+			data[n] = d
+			n++
 		}
 	}
 
-	sort.Sort(entries)
+	return zeroTail(data, n)
+}
 
-	res = make([]T, len(entries))
+// zeroTail zeroes data[n:] and returns data[:n].
+func zeroTail[T any](data []T, n int) []T {
+	var zero T
 
-	for i := range entries {
-		res[i] = entries[i].d
+	for i := n; i < len(data); i++ {
+		data[i] = zero
+	}
+
+	return data[:n]
+}
+
+// UniqKeep selects which entry SliceUniqBy keeps when multiple entries share a key.
+type UniqKeep int
+
+const (
+	// KeepFirst keeps the first entry for each key.
+	KeepFirst UniqKeep = iota
+	// KeepLast keeps the last entry for each key.
+	KeepLast
+)
+
+// SliceUniqBy returns a newly allocated slice containing only one entry
+// for each key returned by the passed UnaryFn, such as deduplicating structs by ID
+// or strings case-insensitively. Either the first or last entry for each key is kept,
+// as selected by keep, and the kept entries are returned in their order in the passed slice.
+func SliceUniqBy[T any, K comparable](data []T, keyFn UnaryFn[T, K], keep UniqKeep) (res []T) {
+	keys := MapSlice(data, keyFn)
+	kept := make(map[K]int, len(data))
+
+	for i, key := range keys {
+		if _, ok := kept[key]; !ok || keep == KeepLast {
+			kept[key] = i
+		}
+	}
+
+	res = make([]T, 0, len(kept))
+
+	for i, key := range keys {
+		if kept[key] == i {
+			res = append(res, data[i])
+		}
+	}
+
+	return
+}
+
+// SliceUniqFunc returns a newly allocated slice containing only the first of any entries
+// which the passed BinaryFn reports as equal, in their order in the passed slice.
+// Unlike SliceUniqBy, entries need not have a comparable key, at the cost
+// of comparing each entry against every entry kept so far (O(n²)).
+func SliceUniqFunc[T any](data []T, eq BinaryFn[T, T, bool]) (res []T) {
+	res = make([]T, 0, len(data))
+
+	for _, d := range data {
+		dup := false
+
+		for _, r := range res {
+			if eq(r, d) {
+				dup = true
+				break
+			}
+		}
+
+		if !dup {
+			res = append(res, d)
+		}
 	}
 
 	return
@@ -109,32 +176,72 @@ func MapSliceError[T, U any](data []T, fn UnaryErrFn[T, U]) (res []U, err error)
 	return
 }
 
+// IndexError records an error along with the index of the slice entry
+// at which it occurred, or at which an operation over a slice stopped.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
 // MapSliceContext calls the passed UnaryCtxFn on all entries in the passed slice,
 // returning a newly allocated slice of the same length containing that output.
-func MapSliceContext[T, U any](ctx context.Context, data []T, fn UnaryCtxFn[T, U]) (res []U) {
-	res = make([]U, len(data))
-
-	for i, d := range data {
-		res[i] = fn(ctx, d)
+// The context is checked before each entry; if it is done, a nil slice
+// and an *IndexError wrapping ctx.Err() and recording the entry reached are returned.
+func MapSliceContext[T, U any](ctx context.Context, data []T, fn UnaryCtxFn[T, U]) (res []U, err error) {
+	res, err = MapSliceContextPartial(ctx, data, fn)
+	if err != nil {
+		res = nil
 	}
 
 	return
 }
 
+// MapSliceContextPartial is identical to MapSliceContext, except that if the context is done,
+// the outputs computed before it was are returned alongside the error,
+// such that len(res) is the index recorded by the *IndexError.
+func MapSliceContextPartial[T, U any](ctx context.Context, data []T, fn UnaryCtxFn[T, U]) (res []U, err error) {
+	return MapSliceContextErrorPartial(ctx, data, fn.ErrFn())
+}
+
 // MapSliceContextError calls the passed UnaryCtxErrFn on all entries in the passed slice.
 // If the function returns an error after being called on any single entry,
 // a nil slice and that error are returned.
+// The context is checked before each entry; if it is done, a nil slice
+// and an *IndexError wrapping ctx.Err() and recording the entry reached are returned.
 // Otherwise, a newly allocated slice of the same length containing the outputs is returned.
 func MapSliceContextError[T, U any](ctx context.Context, data []T, fn UnaryCtxErrFn[T, U]) (res []U, err error) {
+	res, err = MapSliceContextErrorPartial(ctx, data, fn)
+	if err != nil {
+		res = nil
+	}
+
+	return
+}
+
+// MapSliceContextErrorPartial is identical to MapSliceContextError, except that if the function
+// returns an error or the context is done, the outputs computed for the preceding entries
+// are returned alongside the error.
+func MapSliceContextErrorPartial[T, U any](ctx context.Context, data []T, fn UnaryCtxErrFn[T, U]) (res []U, err error) {
 	res = make([]U, len(data))
 
 	for i, d := range data {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return res[:i], &IndexError{Index: i, Err: ctxErr}
+		}
+
 		var fnRes U
 
 		fnRes, err = fn(ctx, d)
 		if err != nil {
-			res = nil
-			return
+			return res[:i], err
 		}
 
 		res[i] = fnRes
@@ -142,3 +249,102 @@ func MapSliceContextError[T, U any](ctx context.Context, data []T, fn UnaryCtxEr
 
 	return
 }
+
+// MultiError collects the errors returned for multiple entries of a slice,
+// as returned by MapSliceErrorAll and MapSliceContextErrorAll.
+// errors.Is and errors.As match a MultiError if they match any of its members.
+type MultiError struct {
+	Errors []*IndexError
+}
+
+func (e *MultiError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%d errors occurred:", len(e.Errors))
+
+	for _, err := range e.Errors {
+		sb.WriteString("\n\t* ")
+		sb.WriteString(err.Error())
+	}
+
+	return sb.String()
+}
+
+// Unwrap returns the member errors, each as an *IndexError.
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// Is reports whether any member error matches target, using errors.Is.
+func (e *MultiError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first member error which matches target, using errors.As.
+func (e *MultiError) As(target any) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MapSliceErrorAll calls the passed UnaryErrFn on all entries in the passed slice,
+// continuing past any errors, and returns a newly allocated slice of the same length
+// containing the outputs. Entries for which the function returned an error
+// are left as the zero value of U.
+// If any errors were returned, they are returned together as a *MultiError, in index order.
+func MapSliceErrorAll[T, U any](data []T, fn UnaryErrFn[T, U]) (res []U, err error) {
+	return MapSliceContextErrorAll(context.Background(), data, func(_ context.Context, d T) (U, error) {
+		return fn(d)
+	})
+}
+
+// MapSliceContextErrorAll is identical to MapSliceErrorAll, except that the context is checked
+// before each entry; if it is done, the outputs for the preceding entries are returned,
+// and an *IndexError wrapping ctx.Err() is added as the last member of the *MultiError.
+func MapSliceContextErrorAll[T, U any](ctx context.Context, data []T, fn UnaryCtxErrFn[T, U]) (res []U, err error) {
+	res = make([]U, len(data))
+
+	var errs []*IndexError
+
+	for i, d := range data {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			res = res[:i]
+			errs = append(errs, &IndexError{Index: i, Err: ctxErr})
+
+			break
+		}
+
+		fnRes, fnErr := fn(ctx, d)
+		if fnErr != nil {
+			errs = append(errs, &IndexError{Index: i, Err: fnErr})
+			continue
+		}
+
+		res[i] = fnRes
+	}
+
+	if len(errs) > 0 {
+		err = &MultiError{Errors: errs}
+	}
+
+	return
+}
@@ -1,9 +1,15 @@
 package xtd_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/jalavosus/xtd"
 )
@@ -44,3 +50,249 @@ func testSliceUniqSafe[T comparable](args, want []T) func(*testing.T) {
 		assert.Equal(t, want, got)
 	}
 }
+
+// cancelAfter returns a UnaryCtxFn which doubles its input,
+// cancelling the returned context once it has been called n times.
+func cancelAfter(n int) (context.Context, xtd.UnaryCtxFn[int, int]) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+
+	return ctx, func(_ context.Context, d int) int {
+		calls++
+		if calls == n {
+			cancel()
+		}
+
+		return d * 2
+	}
+}
+
+func TestMapSliceContext(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		_, fn := cancelAfter(-1)
+
+		got, err := xtd.MapSliceContext(context.Background(), []int{1, 2, 3}, fn)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, got)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, fn := cancelAfter(2)
+
+		got, err := xtd.MapSliceContext(ctx, []int{1, 2, 3, 4}, fn)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, got)
+
+		var idxErr *xtd.IndexError
+		require.ErrorAs(t, err, &idxErr)
+		assert.Equal(t, 2, idxErr.Index)
+		assert.Equal(t, "index 2: context canceled", err.Error())
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		ctx, fn := cancelAfter(2)
+
+		got, err := xtd.MapSliceContextPartial(ctx, []int{1, 2, 3, 4}, fn)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []int{2, 4}, got)
+	})
+}
+
+func TestMapSliceContextError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	fn := func(_ context.Context, d int) (int, error) {
+		if d == 3 {
+			return 0, errBoom
+		}
+
+		return d * 2, nil
+	}
+
+	t.Run("error", func(t *testing.T) {
+		got, err := xtd.MapSliceContextError(context.Background(), []int{1, 2, 3, 4}, fn)
+		assert.ErrorIs(t, err, errBoom)
+		assert.Nil(t, got)
+	})
+
+	t.Run("partial error", func(t *testing.T) {
+		got, err := xtd.MapSliceContextErrorPartial(context.Background(), []int{1, 2, 3, 4}, fn)
+		assert.ErrorIs(t, err, errBoom)
+		assert.Equal(t, []int{2, 4}, got)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		got, err := xtd.MapSliceContextErrorPartial(ctx, []int{1, 2}, fn)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, got)
+	})
+}
+
+type validationError struct {
+	field string
+}
+
+func (e *validationError) Error() string {
+	return "invalid " + e.field
+}
+
+func TestMapSliceErrorAll(t *testing.T) {
+	errNegative := errors.New("negative")
+
+	fn := func(d int) (string, error) {
+		switch {
+		case d < 0:
+			return "", errNegative
+		case d == 0:
+			return "", &validationError{"zero"}
+		}
+
+		return strconv.Itoa(d), nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		got, err := xtd.MapSliceErrorAll([]int{1, 2}, fn)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, got)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		got, err := xtd.MapSliceErrorAll([]int{1, -1, 2, 0, -3}, fn)
+		assert.Equal(t, []string{"1", "", "2", "", ""}, got)
+
+		var multiErr *xtd.MultiError
+		require.ErrorAs(t, err, &multiErr)
+		assert.Equal(t, []int{1, 3, 4}, xtd.MapSlice(multiErr.Errors, func(e *xtd.IndexError) int {
+			return e.Index
+		}))
+
+		assert.ErrorIs(t, err, errNegative)
+
+		var valErr *validationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Equal(t, "zero", valErr.field)
+
+		assert.Len(t, multiErr.Unwrap(), 3)
+		assert.Equal(t, "3 errors occurred:\n\t* index 1: negative\n\t* index 3: invalid zero\n\t* index 4: negative", err.Error())
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		_, err := xtd.MapSliceErrorAll([]int{-1}, fn)
+		assert.EqualError(t, err, "index 0: negative")
+		assert.NotErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestMapSliceContextErrorAll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	got, err := xtd.MapSliceContextErrorAll(ctx, []int{1, -1, 2, 3}, func(_ context.Context, d int) (int, error) {
+		if d == 2 {
+			cancel()
+		}
+
+		if d < 0 {
+			return 0, errors.New("negative")
+		}
+
+		return d, nil
+	})
+
+	assert.Equal(t, []int{1, 0, 2}, got)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.EqualError(t, err, "2 errors occurred:\n\t* index 1: negative\n\t* index 3: context canceled")
+}
+
+type sliceUniqUser struct {
+	ID   int
+	Name string
+}
+
+func TestSliceUniqBy(t *testing.T) {
+	users := []sliceUniqUser{{1, "a"}, {2, "b"}, {1, "c"}, {3, "d"}, {2, "e"}}
+
+	byID := func(u sliceUniqUser) int {
+		return u.ID
+	}
+
+	t.Run("keep first", func(t *testing.T) {
+		got := xtd.SliceUniqBy(users, byID, xtd.KeepFirst)
+		assert.Equal(t, []sliceUniqUser{{1, "a"}, {2, "b"}, {3, "d"}}, got)
+	})
+
+	t.Run("keep last", func(t *testing.T) {
+		got := xtd.SliceUniqBy(users, byID, xtd.KeepLast)
+		assert.Equal(t, []sliceUniqUser{{1, "c"}, {3, "d"}, {2, "e"}}, got)
+	})
+
+	t.Run("case-insensitive", func(t *testing.T) {
+		got := xtd.SliceUniqBy([]string{"Go", "go", "Rust", "GO", "rust"}, strings.ToLower, xtd.KeepFirst)
+		assert.Equal(t, []string{"Go", "Rust"}, got)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, xtd.SliceUniqBy(nil, strings.ToLower, xtd.KeepLast))
+	})
+}
+
+func TestSliceUniqFunc(t *testing.T) {
+	data := [][]int{{1, 2}, {3}, {1, 2}, {}, {3}, nil}
+
+	got := xtd.SliceUniqFunc(data, func(a, b []int) bool {
+		return len(a) == len(b) && fmt.Sprint(a) == fmt.Sprint(b)
+	})
+
+	assert.Equal(t, [][]int{{1, 2}, {3}, {}}, got)
+}
+
+func BenchmarkSliceUniqSafe(b *testing.B) {
+	for _, distinct := range []int{100, 10_000} {
+		data := benchmarkFilterData(distinct)
+
+		b.Run(strconv.Itoa(distinct)+" distinct", func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_ = xtd.SliceUniqSafe(data)
+			}
+		})
+	}
+}
+
+func BenchmarkSliceUniqInPlace(b *testing.B) {
+	for _, distinct := range []int{100, 10_000} {
+		data := benchmarkFilterData(distinct)
+		buf := make([]int, len(data))
+
+		b.Run(strconv.Itoa(distinct)+" distinct", func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				copy(buf, data)
+				_ = xtd.SliceUniqInPlace(buf)
+			}
+		})
+	}
+}
+
+func TestSliceUniqInPlace(t *testing.T) {
+	data := []string{"b", "a", "b", "c", "a"}
+
+	got := xtd.SliceUniqInPlace(data)
+	assert.Equal(t, []string{"b", "a", "c"}, got)
+	assert.Equal(t, []string{"b", "a", "c", "", ""}, data, "tail should be zeroed")
+
+	assert.Empty(t, xtd.SliceUniqInPlace([]int(nil)))
+}
+
+func TestSliceUniqSafe_singleAllocation(t *testing.T) {
+	data := benchmarkFilterData(100)
+
+	got := xtd.SliceUniqSafe(data)
+	assert.Len(t, got, 100)
+	assert.Equal(t, len(data), cap(got), "the result is not copied to trim its capacity")
+}
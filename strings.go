@@ -2,6 +2,8 @@ package xtd
 
 import (
 	"strconv"
+	"strings"
+	"unicode"
 
 	"golang.org/x/exp/constraints"
 )
@@ -15,6 +17,86 @@ func ReverseString(s string) (res string) {
 	return
 }
 
+// SlugOptions configures the output of SlugifyWithOptions.
+type SlugOptions struct {
+	// Separator is placed between words. Defaults to "-" if empty.
+	Separator string
+	// MaxLength, if greater than 0, is the maximum length in bytes of the slug.
+	MaxLength int
+}
+
+// Slugify returns a lowercase, URL-safe "slug" of the passed string, made up solely
+// of ASCII letters and digits with words separated by "-" ("Café Déjà Vu!" -> "cafe-deja-vu").
+// Latin, Greek and Cyrillic letters are transliterated with Transliterate;
+// any other runes act as word separators.
+func Slugify(s string) string {
+	return SlugifyWithOptions(s, SlugOptions{})
+}
+
+// SlugifyWithOptions is identical to Slugify, except that the word separator
+// and maximum slug length are configured by the passed SlugOptions.
+// A slug longer than MaxLength is cut at the last word boundary that fits,
+// so words are never cut in half; only if the very first word is longer than
+// MaxLength is it truncated, rather than returning an empty slug.
+func SlugifyWithOptions(s string, opts SlugOptions) string {
+	sep := opts.Separator
+	if sep == "" {
+		sep = "-"
+	}
+
+	var (
+		sb      strings.Builder
+		pending bool
+	)
+
+	for _, r := range strings.ToLower(Transliterate(s)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if pending && sb.Len() > 0 {
+				sb.WriteString(sep)
+			}
+
+			sb.WriteRune(r)
+			pending = false
+		case unicode.Is(unicode.Mn, r):
+			// Combining marks belong to the preceding letter,
+			// so they must not split words.
+		default:
+			pending = true
+		}
+	}
+
+	slug := sb.String()
+
+	if opts.MaxLength <= 0 || len(slug) <= opts.MaxLength {
+		return slug
+	}
+
+	if i := strings.LastIndex(slug[:minOf(len(slug), opts.MaxLength+len(sep))], sep); i > 0 {
+		return slug[:i]
+	}
+
+	return slug[:opts.MaxLength]
+}
+
+// UniqueSlug returns the passed slug if it is not present in used,
+// or otherwise the slug suffixed with sep and the lowest number,
+// starting at 2, which yields a slug not present in used
+// ("report", "report-2", "report-3", ...).
+// used is not modified; callers should add the returned slug to it themselves.
+func UniqueSlug(slug, sep string, used map[string]bool) string {
+	if !used[slug] {
+		return slug
+	}
+
+	for n := 2; ; n++ {
+		candidate := slug + sep + strconv.Itoa(n)
+		if !used[candidate] {
+			return candidate
+		}
+	}
+}
+
 // IntFromString wraps strconv.ParseInt, returning any of int(8/16/32/64)
 // based on the specified type constraint.
 func IntFromString[T constraints.Signed](s string) (res T, err error) {
@@ -434,3 +434,57 @@ func TestReverseString(t *testing.T) {
 		})
 	}
 }
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{"latin", "Café Déjà Vu!", "cafe-deja-vu"},
+		{"punctuation", "  Hello,   World -- 2024 ", "hello-world-2024"},
+		{"german", "Straße Über", "strasse-uber"},
+		{"greek", "Καλημέρα κόσμε", "kalimera-kosme"},
+		{"cyrillic", "Привет, мир", "privet-mir"},
+		{"combining", "café", "cafe"},
+		{"unsupported script", "日本 go", "go"},
+		{"empty", "!!!", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Slugify(tt.arg))
+		})
+	}
+}
+
+func TestSlugifyWithOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		opts xtd.SlugOptions
+		want string
+	}{
+		{"separator", "Café Déjà Vu", xtd.SlugOptions{Separator: "_"}, "cafe_deja_vu"},
+		{"max length at boundary", "Café Déjà Vu", xtd.SlugOptions{MaxLength: 9}, "cafe-deja"},
+		{"max length mid word", "Café Déjà Vu", xtd.SlugOptions{MaxLength: 11}, "cafe-deja"},
+		{"max length fits", "Café Déjà Vu", xtd.SlugOptions{MaxLength: 12}, "cafe-deja-vu"},
+		{"max length first word", "Supercalifragilistic day", xtd.SlugOptions{MaxLength: 5}, "super"},
+		{"multi-byte separator at boundary", "ab cde f", xtd.SlugOptions{Separator: "--", MaxLength: 7}, "ab--cde"},
+		{"multi-byte separator mid separator", "ab cde f", xtd.SlugOptions{Separator: "--", MaxLength: 8}, "ab--cde"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.SlugifyWithOptions(tt.arg, tt.opts))
+		})
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	used := map[string]bool{"report": true, "report-2": true}
+
+	assert.Equal(t, "summary", xtd.UniqueSlug("summary", "-", used))
+	assert.Equal(t, "report-3", xtd.UniqueSlug("report", "-", used))
+	assert.Equal(t, "report_2", xtd.UniqueSlug("report", "_", used))
+}
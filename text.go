@@ -0,0 +1,182 @@
+package xtd
+
+import (
+	"strings"
+)
+
+// SplitLines splits the passed string into lines, removing their
+// "\n" or "\r\n" line endings.
+// A trailing line ending does not produce an extra empty line,
+// so "a\nb\n" and "a\nb" both split into ["a", "b"].
+func SplitLines(s string) []string {
+	lines := make([]string, 0, strings.Count(s, "\n")+1)
+
+	forEachLine(s, func(line, _ string) {
+		lines = append(lines, line)
+	})
+
+	return lines
+}
+
+// SplitLinesKeepEnds is identical to SplitLines, except that each line
+// retains its original "\n" or "\r\n" ending (if any),
+// such that strings.Join(SplitLinesKeepEnds(s), "") == s.
+func SplitLinesKeepEnds(s string) []string {
+	var (
+		lines  = make([]string, 0, strings.Count(s, "\n")+1)
+		offset int
+	)
+
+	forEachLine(s, func(line, ending string) {
+		end := offset + len(line) + len(ending)
+		lines = append(lines, s[offset:end])
+		offset = end
+	})
+
+	return lines
+}
+
+// MapLines calls the passed UnaryFn on each line of the passed string,
+// with its line ending removed, and returns the outputs joined back together
+// using the original "\n" or "\r\n" ending of each line.
+func MapLines(s string, fn UnaryFn[string, string]) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	forEachLine(s, func(line, ending string) {
+		sb.WriteString(fn(line))
+		sb.WriteString(ending)
+	})
+
+	return sb.String()
+}
+
+// Indent returns the passed string with prefix added to the start of each line.
+// Lines consisting solely of whitespace are left as-is,
+// so as not to introduce trailing whitespace.
+func Indent(s, prefix string) string {
+	return MapLines(s, func(line string) string {
+		if isBlank(line) {
+			return line
+		}
+
+		return prefix + line
+	})
+}
+
+// Dedent returns the passed string with any leading whitespace common to
+// all of its lines removed, in the manner of Python's textwrap.dedent.
+// This allows indented raw string literals to be written in line with the
+// surrounding code. Tabs and spaces are not considered equivalent.
+// Lines consisting solely of whitespace are ignored when computing the common
+// whitespace, and are replaced by empty lines in the output.
+func Dedent(s string) string {
+	var (
+		margin    string
+		hasMargin bool
+	)
+
+	forEachLine(s, func(line, _ string) {
+		if isBlank(line) {
+			return
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+
+		if !hasMargin {
+			margin, hasMargin = indent, true
+			return
+		}
+
+		margin = commonPrefix(margin, indent)
+	})
+
+	return MapLines(s, func(line string) string {
+		if isBlank(line) {
+			return ""
+		}
+
+		return line[len(margin):]
+	})
+}
+
+// TrimMargin removes, from each line of the passed string, any leading whitespace
+// followed by marginPrefix, in the manner of Kotlin's trimMargin.
+// Lines without the margin prefix are left as-is.
+// The first and last lines are removed entirely if they are blank,
+// and the result never ends with a line ending.
+// If marginPrefix is empty, "|" is used.
+func TrimMargin(s, marginPrefix string) string {
+	if marginPrefix == "" {
+		marginPrefix = "|"
+	}
+
+	lines := SplitLinesKeepEnds(s)
+
+	if len(lines) > 0 && isBlank(lines[0]) {
+		lines = lines[1:]
+	}
+
+	if len(lines) > 0 && isBlank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) > 0 {
+		// A line ending on the final line implies a blank line after it,
+		// which is removed along with the ending separating them.
+		lines[len(lines)-1] = strings.TrimSuffix(strings.TrimSuffix(lines[len(lines)-1], "\n"), "\r")
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+
+		if strings.HasPrefix(trimmed, marginPrefix) {
+			line = trimmed[len(marginPrefix):]
+		}
+
+		sb.WriteString(line)
+	}
+
+	return sb.String()
+}
+
+// forEachLine calls fn with each line of s and its line ending,
+// which is one of "\n", "\r\n", or "" for a final unterminated line.
+// A trailing line ending does not produce an extra empty line.
+func forEachLine(s string, fn func(line, ending string)) {
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\n')
+		if i == -1 {
+			fn(s, "")
+			return
+		}
+
+		line, ending := s[:i], s[i:i+1]
+		if strings.HasSuffix(line, "\r") {
+			line, ending = line[:len(line)-1], s[i-1:i+1]
+		}
+
+		fn(line, ending)
+
+		s = s[i+1:]
+	}
+}
+
+func isBlank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+func commonPrefix(a, b string) string {
+	n := minOf(len(a), len(b))
+
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return a[:i]
+		}
+	}
+
+	return a[:n]
+}
@@ -0,0 +1,101 @@
+package xtd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestSplitLines(t *testing.T) {
+	tests := []struct {
+		name         string
+		arg          string
+		want         []string
+		wantKeepEnds []string
+	}{
+		{"empty", "", []string{}, []string{}},
+		{"single", "a", []string{"a"}, []string{"a"}},
+		{"lf", "a\nb\n", []string{"a", "b"}, []string{"a\n", "b\n"}},
+		{"crlf", "a\r\nb", []string{"a", "b"}, []string{"a\r\n", "b"}},
+		{"mixed", "a\r\n\nb\r", []string{"a", "", "b\r"}, []string{"a\r\n", "\n", "b\r"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.SplitLines(tt.arg))
+
+			keepEnds := xtd.SplitLinesKeepEnds(tt.arg)
+			assert.Equal(t, tt.wantKeepEnds, keepEnds)
+			assert.Equal(t, tt.arg, strings.Join(keepEnds, ""))
+		})
+	}
+}
+
+func TestMapLines(t *testing.T) {
+	got := xtd.MapLines("a\r\nb\nc", strings.ToUpper)
+	assert.Equal(t, "A\r\nB\nC", got)
+}
+
+func TestIndent(t *testing.T) {
+	tests := []struct {
+		name   string
+		arg    string
+		prefix string
+		want   string
+	}{
+		{"simple", "a\nb", "  ", "  a\n  b"},
+		{"blank lines", "a\n\n  \nb\n", "\t", "\ta\n\n  \n\tb\n"},
+		{"crlf", "a\r\nb\r\n", "> ", "> a\r\n> b\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Indent(tt.arg, tt.prefix))
+		})
+	}
+}
+
+func TestDedent(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{"none", "a\n b", "a\n b"},
+		{"common", "    a\n      b\n    c\n", "a\n  b\nc\n"},
+		{"blank lines ignored", "  a\n\n \n    b", "a\n\n\n  b"},
+		{"tabs and spaces differ", "\ta\n    b", "\ta\n    b"},
+		{"crlf", "  a\r\n  b\r\n", "a\r\nb\r\n"},
+		{"all blank", "  \n \n", "\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Dedent(tt.arg))
+		})
+	}
+}
+
+func TestTrimMargin(t *testing.T) {
+	tests := []struct {
+		name   string
+		arg    string
+		prefix string
+		want   string
+	}{
+		{"default prefix", "\n    |a\n    |  b\n    ", "", "a\n  b"},
+		{"custom prefix", "\n  >a\n  >b\n", ">", "a\nb"},
+		{"no prefix kept", "|a\n  b\n|c", "", "a\n  b\nc"},
+		{"crlf", "\r\n  |a\r\n  |b\r\n", "", "a\r\nb"},
+		{"empty", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.TrimMargin(tt.arg, tt.prefix))
+		})
+	}
+}
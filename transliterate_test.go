@@ -0,0 +1,30 @@
+package xtd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{"ascii", "Hello", "Hello"},
+		{"latin", "Déjà Vu Ærø", "Deja Vu Aero"},
+		{"greek", "Ψυχή", "Psychi"},
+		{"cyrillic", "Жёлтый Щит", "Zhyoltyy Shchit"},
+		{"soft sign", "Объём", "Obyom"},
+		{"unsupported", "日本", "日本"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.Transliterate(tt.arg))
+		})
+	}
+}
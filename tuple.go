@@ -0,0 +1,122 @@
+package xtd
+
+// Pair is a generic two-element tuple.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a generic three-element tuple.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewPair returns a Pair of the passed values.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{first, second}
+}
+
+// Unpack returns the elements of the Pair, for use in multiple assignment.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// NewTriple returns a Triple of the passed values.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{first, second, third}
+}
+
+// Unpack returns the elements of the Triple, for use in multiple assignment.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// Tupled "wraps" a BinaryFn in a UnaryFn which takes both of its inputs as a Pair,
+// such that it can be passed to MapSlice and friends over a slice of pairs:
+//
+//	MapSlice(Zip(names, values), BinaryFn[string, int, string](format).Tupled())
+func (fn BinaryFn[T, U, V]) Tupled() UnaryFn[Pair[T, U], V] {
+	return func(p Pair[T, U]) V {
+		return fn(p.First, p.Second)
+	}
+}
+
+// Zip returns a newly allocated slice of pairs of the entries at the same index
+// in the passed slices, the length of which is that of the shorter slice.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	res := make([]Pair[A, B], minOf(len(a), len(b)))
+
+	for i := range res {
+		res[i] = Pair[A, B]{a[i], b[i]}
+	}
+
+	return res
+}
+
+// ZipLongest is identical to Zip, except that the returned slice has the length
+// of the longer slice, with the missing entries of the shorter slice
+// taking the value fillA or fillB respectively.
+func ZipLongest[A, B any](a []A, b []B, fillA A, fillB B) []Pair[A, B] {
+	res := make([]Pair[A, B], maxOf(len(a), len(b)))
+
+	for i := range res {
+		res[i] = Pair[A, B]{fillA, fillB}
+
+		if i < len(a) {
+			res[i].First = a[i]
+		}
+
+		if i < len(b) {
+			res[i].Second = b[i]
+		}
+	}
+
+	return res
+}
+
+// Unzip is the inverse of Zip, returning newly allocated slices
+// of the first and second elements of the passed pairs.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a, b := make([]A, len(pairs)), make([]B, len(pairs))
+
+	for i, p := range pairs {
+		a[i], b[i] = p.First, p.Second
+	}
+
+	return a, b
+}
+
+// Zip3 is identical to Zip, but for three slices, returning triples.
+func Zip3[A, B, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	res := make([]Triple[A, B, C], minOf(len(a), minOf(len(b), len(c))))
+
+	for i := range res {
+		res[i] = Triple[A, B, C]{a[i], b[i], c[i]}
+	}
+
+	return res
+}
+
+// Unzip3 is the inverse of Zip3.
+func Unzip3[A, B, C any](triples []Triple[A, B, C]) ([]A, []B, []C) {
+	a, b, c := make([]A, len(triples)), make([]B, len(triples)), make([]C, len(triples))
+
+	for i, t := range triples {
+		a[i], b[i], c[i] = t.First, t.Second, t.Third
+	}
+
+	return a, b, c
+}
+
+// Enumerate returns a newly allocated slice pairing each entry of the passed slice with its index.
+func Enumerate[T any](data []T) []Pair[int, T] {
+	res := make([]Pair[int, T], len(data))
+
+	for i, d := range data {
+		res[i] = Pair[int, T]{i, d}
+	}
+
+	return res
+}
@@ -0,0 +1,65 @@
+package xtd_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestZip(t *testing.T) {
+	names := []string{"a", "b", "c"}
+	values := []int{1, 2}
+
+	assert.Equal(t, []xtd.Pair[string, int]{{"a", 1}, {"b", 2}}, xtd.Zip(names, values))
+	assert.Empty(t, xtd.Zip(names, []int(nil)))
+
+	assert.Equal(t, []xtd.Pair[string, int]{{"a", 1}, {"b", 2}, {"c", -1}}, xtd.ZipLongest(names, values, "", -1))
+	assert.Equal(t, []xtd.Pair[string, int]{{"?", 1}}, xtd.ZipLongest(nil, []int{1}, "?", 0))
+}
+
+func TestUnzip(t *testing.T) {
+	names := []string{"a", "b"}
+	values := []int{1, 2}
+
+	gotNames, gotValues := xtd.Unzip(xtd.Zip(names, values))
+	assert.Equal(t, names, gotNames)
+	assert.Equal(t, values, gotValues)
+}
+
+func TestZip3(t *testing.T) {
+	triples := xtd.Zip3([]string{"a", "b"}, []int{1, 2, 3}, []bool{true, false})
+	assert.Equal(t, []xtd.Triple[string, int, bool]{{"a", 1, true}, {"b", 2, false}}, triples)
+
+	a, b, c := xtd.Unzip3(triples)
+	assert.Equal(t, []string{"a", "b"}, a)
+	assert.Equal(t, []int{1, 2}, b)
+	assert.Equal(t, []bool{true, false}, c)
+
+	first, second, third := triples[1].Unpack()
+	assert.Equal(t, "b", first)
+	assert.Equal(t, 2, second)
+	assert.False(t, third)
+}
+
+func TestEnumerate(t *testing.T) {
+	got := xtd.Enumerate([]string{"x", "y"})
+	assert.Equal(t, []xtd.Pair[int, string]{{0, "x"}, {1, "y"}}, got)
+
+	i, v := got[1].Unpack()
+	assert.Equal(t, 1, i)
+	assert.Equal(t, "y", v)
+}
+
+func TestBinaryFn_Tupled(t *testing.T) {
+	format := xtd.BinaryFn[string, int, string](func(name string, value int) string {
+		return fmt.Sprintf("%s=%d", name, value)
+	})
+
+	got := xtd.MapSlice(xtd.Zip([]string{"a", "b"}, []int{1, 2}), format.Tupled())
+	assert.Equal(t, []string{"a=1", "b=2"}, got)
+
+	assert.Equal(t, "c=3", format.Tupled()(xtd.NewPair("c", 3)))
+}
@@ -0,0 +1,245 @@
+package xtd
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// wideTable approximates the East Asian Wide (W) and Fullwidth (F)
+// ranges of Unicode's EastAsianWidth.txt, along with the emoji blocks
+// which terminals render using two columns.
+var wideTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+		{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+		{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33ff, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1},
+		{Lo: 0xa000, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16fe0, Hi: 0x16fe4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18cff, Stride: 1},
+		{Lo: 0x1b000, Hi: 0x1b2ff, Stride: 1},
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1f2ff, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+}
+
+// RuneWidth returns the number of terminal columns the passed rune occupies
+// when displayed: 0 for control characters, combining marks and other
+// zero-width runes, 2 for East Asian Wide/Fullwidth characters and most emoji,
+// and 1 for everything else.
+func RuneWidth(r rune) int {
+	switch {
+	case r < 0x20, r >= 0x7f && r < 0xa0:
+		return 0
+	case r < 0x7f:
+		return 1
+	case r >= 0x1160 && r <= 0x11ff:
+		// Hangul medial vowels and final consonants, which combine
+		// with a preceding leading consonant.
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case unicode.Is(wideTable, r):
+		return 2
+	}
+
+	return 1
+}
+
+// StringWidth returns the number of terminal columns the passed string
+// occupies when displayed, that being the sum of the RuneWidth of its runes.
+func StringWidth(s string) (width int) {
+	for _, r := range s {
+		width += RuneWidth(r)
+	}
+
+	return
+}
+
+// TruncateWidth returns the passed string truncated so that
+// it occupies at most width terminal columns, ending with ellipsis
+// if any truncation took place.
+// The ellipsis counts towards width; if it alone is wider than width,
+// it is itself truncated. Runes are never split, so the result may be
+// narrower than width when a wide rune does not fit.
+func TruncateWidth(s string, width int, ellipsis string) string {
+	if StringWidth(s) <= width {
+		return s
+	}
+
+	ellipsisWidth := StringWidth(ellipsis)
+	if ellipsisWidth > width {
+		return truncateWidth(ellipsis, width)
+	}
+
+	return truncateWidth(s, width-ellipsisWidth) + ellipsis
+}
+
+func truncateWidth(s string, width int) string {
+	var w int
+
+	for i, r := range s {
+		w += RuneWidth(r)
+		if w > width {
+			return s[:i]
+		}
+	}
+
+	return s
+}
+
+// PadRight returns the passed string followed by as many spaces as are needed
+// for it to occupy width terminal columns, left-aligning it.
+// Strings already at least width columns wide are returned as-is.
+func PadRight(s string, width int) string {
+	n := width - StringWidth(s)
+	if n <= 0 {
+		return s
+	}
+
+	return s + strings.Repeat(" ", n)
+}
+
+// PadLeft returns the passed string preceded by as many spaces as are needed
+// for it to occupy width terminal columns, right-aligning it.
+// Strings already at least width columns wide are returned as-is.
+func PadLeft(s string, width int) string {
+	n := width - StringWidth(s)
+	if n <= 0 {
+		return s
+	}
+
+	return strings.Repeat(" ", n) + s
+}
+
+// PadCenter returns the passed string surrounded by as many spaces as are needed
+// for it to occupy width terminal columns, centering it.
+// When the padding cannot be split evenly, the extra space goes on the right.
+// Strings already at least width columns wide are returned as-is.
+func PadCenter(s string, width int) string {
+	n := width - StringWidth(s)
+	if n <= 0 {
+		return s
+	}
+
+	left := n / 2
+
+	return strings.Repeat(" ", left) + s + strings.Repeat(" ", n-left)
+}
+
+// WrapWidth word-wraps the passed string so that no line occupies more
+// than width terminal columns, returning the wrapped lines joined by "\n".
+// Existing line breaks are preserved, runs of whitespace between words
+// are collapsed to a single space, and words wider than width
+// are broken across lines.
+// If width is less than 1, the passed string is returned as-is.
+func WrapWidth(s string, width int) string {
+	if width < 1 {
+		return s
+	}
+
+	var sb strings.Builder
+
+	for i, line := range strings.Split(s, "\n") {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+
+		wrapLine(&sb, line, width)
+	}
+
+	return sb.String()
+}
+
+func wrapLine(sb *strings.Builder, line string, width int) {
+	var lineWidth int
+
+	for _, word := range strings.Fields(line) {
+		wordWidth := StringWidth(word)
+
+		switch {
+		case lineWidth == 0:
+		case lineWidth+1+wordWidth <= width:
+			sb.WriteByte(' ')
+			lineWidth++
+		default:
+			sb.WriteByte('\n')
+			lineWidth = 0
+		}
+
+		for wordWidth > width-lineWidth {
+			head := truncateWidth(word, width-lineWidth)
+			if head == "" {
+				// A single rune wider than the line; emit it anyway
+				// rather than looping forever.
+				_, size := utf8.DecodeRuneInString(word)
+				head = word[:size]
+			}
+
+			sb.WriteString(head)
+			sb.WriteByte('\n')
+
+			word = word[len(head):]
+			wordWidth = StringWidth(word)
+			lineWidth = 0
+		}
+
+		sb.WriteString(word)
+		lineWidth += wordWidth
+	}
+}
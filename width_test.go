@@ -0,0 +1,104 @@
+package xtd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/xtd"
+)
+
+func TestStringWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ascii", "hello", 5},
+		{"cjk", "日本語", 6},
+		{"fullwidth", "ＡＢ", 4},
+		{"hangul", "한국어", 6},
+		{"combining", "é", 1},
+		{"zero-width joiner", "a‍b", 2},
+		{"emoji", "🚀 go", 5},
+		{"control", "a\tb", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.StringWidth(tt.arg))
+		})
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		arg      string
+		width    int
+		ellipsis string
+		want     string
+	}{
+		{"fits", "hello", 5, "…", "hello"},
+		{"ascii", "hello world", 8, "...", "hello..."},
+		{"unicode ellipsis", "hello world", 6, "…", "hello…"},
+		{"cjk", "日本語テキスト", 7, "…", "日本語…"},
+		{"cjk no split", "日本語テキスト", 6, "…", "日本…"},
+		{"combining kept", "café society", 5, "…", "café…"},
+		{"ellipsis too wide", "hello world", 2, "...", ".."},
+		{"no ellipsis", "hello world", 5, "", "hello"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := xtd.TruncateWidth(tt.arg, tt.width, tt.ellipsis)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, xtd.StringWidth(got), tt.width)
+		})
+	}
+}
+
+func TestPadding(t *testing.T) {
+	tests := []struct {
+		name                string
+		arg                 string
+		width               int
+		left, right, center string
+	}{
+		{"ascii", "ab", 5, "   ab", "ab   ", " ab  "},
+		{"cjk", "日本", 6, "  日本", "日本  ", " 日本 "},
+		{"too wide", "hello", 3, "hello", "hello", "hello"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.left, xtd.PadLeft(tt.arg, tt.width))
+			assert.Equal(t, tt.right, xtd.PadRight(tt.arg, tt.width))
+			assert.Equal(t, tt.center, xtd.PadCenter(tt.arg, tt.width))
+		})
+	}
+}
+
+func TestWrapWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		arg   string
+		width int
+		want  string
+	}{
+		{"fits", "hello world", 20, "hello world"},
+		{"simple", "the quick brown fox", 10, "the quick\nbrown fox"},
+		{"collapses spaces", "the   quick", 20, "the quick"},
+		{"keeps newlines", "ab cd\nef", 5, "ab cd\nef"},
+		{"long word", "abcdefghij", 4, "abcd\nefgh\nij"},
+		{"cjk", "日本語テキスト", 6, "日本語\nテキス\nト"},
+		{"zero width", "hello", 0, "hello"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xtd.WrapWidth(tt.arg, tt.width))
+		})
+	}
+}